@@ -0,0 +1,181 @@
+package reportengine
+
+import (
+	"unicode"
+)
+
+// Presentation forms of the Arabic letters: isolated, final, initial, medial.
+// The letters without initial and medial forms join only the letter before them (right-joining).
+var arabicForms = map[rune][4]rune{
+	0x0621: {0xFE80},
+	0x0622: {0xFE81, 0xFE82},
+	0x0623: {0xFE83, 0xFE84},
+	0x0624: {0xFE85, 0xFE86},
+	0x0625: {0xFE87, 0xFE88},
+	0x0626: {0xFE89, 0xFE8A, 0xFE8B, 0xFE8C},
+	0x0627: {0xFE8D, 0xFE8E},
+	0x0628: {0xFE8F, 0xFE90, 0xFE91, 0xFE92},
+	0x0629: {0xFE93, 0xFE94},
+	0x062A: {0xFE95, 0xFE96, 0xFE97, 0xFE98},
+	0x062B: {0xFE99, 0xFE9A, 0xFE9B, 0xFE9C},
+	0x062C: {0xFE9D, 0xFE9E, 0xFE9F, 0xFEA0},
+	0x062D: {0xFEA1, 0xFEA2, 0xFEA3, 0xFEA4},
+	0x062E: {0xFEA5, 0xFEA6, 0xFEA7, 0xFEA8},
+	0x062F: {0xFEA9, 0xFEAA},
+	0x0630: {0xFEAB, 0xFEAC},
+	0x0631: {0xFEAD, 0xFEAE},
+	0x0632: {0xFEAF, 0xFEB0},
+	0x0633: {0xFEB1, 0xFEB2, 0xFEB3, 0xFEB4},
+	0x0634: {0xFEB5, 0xFEB6, 0xFEB7, 0xFEB8},
+	0x0635: {0xFEB9, 0xFEBA, 0xFEBB, 0xFEBC},
+	0x0636: {0xFEBD, 0xFEBE, 0xFEBF, 0xFEC0},
+	0x0637: {0xFEC1, 0xFEC2, 0xFEC3, 0xFEC4},
+	0x0638: {0xFEC5, 0xFEC6, 0xFEC7, 0xFEC8},
+	0x0639: {0xFEC9, 0xFECA, 0xFECB, 0xFECC},
+	0x063A: {0xFECD, 0xFECE, 0xFECF, 0xFED0},
+	0x0641: {0xFED1, 0xFED2, 0xFED3, 0xFED4},
+	0x0642: {0xFED5, 0xFED6, 0xFED7, 0xFED8},
+	0x0643: {0xFED9, 0xFEDA, 0xFEDB, 0xFEDC},
+	0x0644: {0xFEDD, 0xFEDE, 0xFEDF, 0xFEE0},
+	0x0645: {0xFEE1, 0xFEE2, 0xFEE3, 0xFEE4},
+	0x0646: {0xFEE5, 0xFEE6, 0xFEE7, 0xFEE8},
+	0x0647: {0xFEE9, 0xFEEA, 0xFEEB, 0xFEEC},
+	0x0648: {0xFEED, 0xFEEE},
+	0x0649: {0xFEEF, 0xFEF0},
+	0x064A: {0xFEF1, 0xFEF2, 0xFEF3, 0xFEF4},
+	0x067E: {0xFB56, 0xFB57, 0xFB58, 0xFB59}, //Persian
+	0x0686: {0xFB7A, 0xFB7B, 0xFB7C, 0xFB7D},
+	0x0698: {0xFB8A, 0xFB8B},
+	0x06A9: {0xFB8E, 0xFB8F, 0xFB90, 0xFB91},
+	0x06AF: {0xFB92, 0xFB93, 0xFB94, 0xFB95},
+	0x06CC: {0xFBFC, 0xFBFD, 0xFBFE, 0xFBFF},
+}
+
+// Ligatures of lam with the alef that follows it: isolated, final
+var arabicLamAlef = map[rune][2]rune{
+	0x0622: {0xFEF5, 0xFEF6},
+	0x0623: {0xFEF7, 0xFEF8},
+	0x0625: {0xFEF9, 0xFEFA},
+	0x0627: {0xFEFB, 0xFEFC},
+}
+
+const arabicLam = 0x0644
+
+// Joining types of the Arabic characters
+const (
+	joinNone        = iota
+	joinRight       //Joins the letter before it
+	joinDual        //Joins the letters before and after it
+	joinCausing     //Tatweel and zero width joiner, that join both sides without changing form
+	joinTransparent //Marks, skipped
+)
+
+func arabicJoining(r rune) int {
+	if forms, ok := arabicForms[r]; ok {
+		if forms[2] != 0 {
+			return joinDual
+		}
+		if forms[1] != 0 {
+			return joinRight
+		}
+		return joinNone
+	}
+	switch {
+	case r == 0x0640 || r == 0x200D:
+		return joinCausing
+	case unicode.Is(unicode.Mn, r) && (unicode.Is(unicode.Arabic, r) || unicode.Is(unicode.Inherited, r)):
+		return joinTransparent
+	}
+	return joinNone
+}
+
+// Position of a character in the tokens
+type arabicChar struct {
+	token int
+	index int
+	r     rune
+}
+
+// Replace the Arabic letters of the text tokens with their contextual forms (initial, medial, final, isolated)
+// and lam-alef with its ligature. The letters join across the tokens of different styles of a word,
+// but not across icons and fields.
+func shapeArabic(tokens []Token) []Token {
+	runes := make([][]rune, len(tokens))
+	chars := make([]arabicChar, 0)
+	arabic := false
+	for i, token := range tokens {
+		if token.field != "" || token.fontFamily == IconFontFamily {
+			chars = append(chars, arabicChar{token: i, index: -1})
+			continue
+		}
+		runes[i] = []rune(token.value)
+		for j, r := range runes[i] {
+			chars = append(chars, arabicChar{token: i, index: j, r: r})
+			if _, ok := arabicForms[r]; ok {
+				arabic = true
+			}
+		}
+	}
+	if !arabic {
+		return tokens
+	}
+	//Joining type of the character not transparent before and after every character
+	joining := func(i, step int) int {
+		for i += step; i >= 0 && i < len(chars); i += step {
+			if chars[i].index < 0 {
+				return joinNone
+			}
+			if j := arabicJoining(chars[i].r); j != joinTransparent {
+				return j
+			}
+		}
+		return joinNone
+	}
+	removed := make(map[[2]int]bool) //Token and index of the alefs of the ligatures
+	for i, c := range chars {
+		forms, ok := arabicForms[c.r]
+		if c.index < 0 || !ok || removed[[2]int{c.token, c.index}] {
+			continue
+		}
+		current := arabicJoining(c.r)
+		before, after := joining(i, -1), joining(i, 1)
+		joinsBefore := current != joinNone && (before == joinDual || before == joinCausing)
+		joinsAfter := current == joinDual && (after == joinDual || after == joinRight || after == joinCausing)
+		if c.r == arabicLam && i+1 < len(chars) && chars[i+1].token == c.token {
+			if ligature, ok := arabicLamAlef[chars[i+1].r]; ok {
+				form := ligature[0]
+				if joinsBefore {
+					form = ligature[1]
+				}
+				runes[c.token][c.index] = form
+				removed[[2]int{c.token, c.index + 1}] = true
+				continue
+			}
+		}
+		switch {
+		case joinsBefore && joinsAfter:
+			runes[c.token][c.index] = forms[3]
+		case joinsBefore:
+			runes[c.token][c.index] = forms[1]
+		case joinsAfter:
+			runes[c.token][c.index] = forms[2]
+		default:
+			runes[c.token][c.index] = forms[0]
+		}
+	}
+	shaped := make([]Token, len(tokens))
+	for i, token := range tokens {
+		shaped[i] = token
+		if runes[i] == nil {
+			continue
+		}
+		value := make([]rune, 0, len(runes[i]))
+		for j, r := range runes[i] {
+			if !removed[[2]int{i, j}] {
+				value = append(value, r)
+			}
+		}
+		shaped[i].value = string(value)
+	}
+	return shaped
+}
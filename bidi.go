@@ -0,0 +1,353 @@
+package reportengine
+
+import (
+	"golang.org/x/text/unicode/bidi"
+	"sort"
+)
+
+// Unit of the bidirectional algorithm: a character of a text token or a whole icon or field token
+type bidiUnit struct {
+	token int
+	value string
+	class bidi.Class
+}
+
+// Mirrored characters (Bidi_Mirrored) that are not paired brackets, x/text has only the data of the brackets
+var bidiMirrors = map[rune]rune{
+	'<': '>', '>': '<', '«': '»', '»': '«', '‹': '›', '›': '‹', '≤': '≥', '≥': '≤',
+}
+
+// Return the character written in a right-to-left run: the paired brackets and the other mirrored characters
+// are swapped
+func bidiMirror(r rune) rune {
+	if properties, _ := bidi.LookupRune(r); properties.IsBracket() {
+		for _, mirror := range bidi.ReverseString(string(r)) {
+			return mirror
+		}
+	}
+	if mirror, ok := bidiMirrors[r]; ok {
+		return mirror
+	}
+	return r
+}
+
+// Return true if the first strong character of the tokens is right-to-left (Hebrew, Arabic, ...)
+func isRightToLeft(tokens []Token) bool {
+	for _, token := range tokens {
+		if token.field != "" || token.fontFamily == IconFontFamily {
+			continue
+		}
+		for _, r := range token.value {
+			switch bidiClass(r) {
+			case bidi.L:
+				return false
+			case bidi.R, bidi.AL:
+				return true
+			}
+		}
+	}
+	return false
+}
+func bidiClass(r rune) bidi.Class {
+	properties, _ := bidi.LookupRune(r)
+	return properties.Class()
+}
+
+// Return the tokens of a line in visual order, with the Unicode bidirectional algorithm (UAX #9) for a
+// paragraph with direction rtl, the brackets are paired with the data of x/text. The explicit embeddings
+// and isolates are not supported (they are neutral).
+// The tokens are split where the direction changes, the icons and the fields are never split.
+func visualTokens(tokens []Token, rtl bool) []Token {
+	units := make([]bidiUnit, 0)
+	mixed := rtl
+	for i, token := range tokens {
+		if token.field != "" || token.fontFamily == IconFontFamily {
+			units = append(units, bidiUnit{token: i, class: bidi.ON})
+			continue
+		}
+		for _, r := range token.value {
+			unit := bidiUnit{token: i, value: string(r), class: bidiClass(r)}
+			if unit.class == bidi.R || unit.class == bidi.AL || unit.class == bidi.AN {
+				mixed = true
+			}
+			units = append(units, unit)
+		}
+	}
+	if !mixed { //Only left-to-right text
+		return tokens
+	}
+	levels := bidiLevels(units, rtl)
+	visual := make([]Token, 0, len(tokens))
+	last := -1
+	for _, i := range bidiOrder(levels) {
+		unit := units[i]
+		if unit.value == "" { //Icon or field
+			visual = append(visual, tokens[unit.token])
+			last = -1
+			continue
+		}
+		value := unit.value
+		if levels[i]%2 == 1 {
+			value = string(bidiMirror([]rune(value)[0]))
+		}
+		if unit.token == last {
+			visual[len(visual)-1].value += value
+			continue
+		}
+		token := tokens[unit.token]
+		token.value = value
+		visual = append(visual, token)
+		last = unit.token
+	}
+	return visual
+}
+
+// Return the embedding level of every unit (rules W1-W7, N0-N2, I1-I2 and L1)
+func bidiLevels(units []bidiUnit, rtl bool) []int {
+	n := len(units)
+	base, sos := 0, bidi.L
+	if rtl {
+		base, sos = 1, bidi.R
+	}
+	types := make([]bidi.Class, n)
+	for i, unit := range units {
+		types[i] = unit.class
+		switch types[i] {
+		case bidi.LRE, bidi.RLE, bidi.LRO, bidi.RLO, bidi.PDF, bidi.LRI, bidi.RLI, bidi.FSI, bidi.PDI, bidi.BN:
+			types[i] = bidi.ON
+		}
+	}
+	//W1: a mark takes the type of the character before it
+	prev := sos
+	for i := range types {
+		if types[i] == bidi.NSM {
+			types[i] = prev
+		}
+		prev = types[i]
+	}
+	//W2, W3: European numbers after Arabic letters are Arabic numbers, Arabic letters are right-to-left
+	strong := sos
+	for i := range types {
+		switch types[i] {
+		case bidi.L, bidi.R:
+			strong = types[i]
+		case bidi.AL:
+			strong = bidi.AL
+			types[i] = bidi.R
+		case bidi.EN:
+			if strong == bidi.AL {
+				types[i] = bidi.AN
+			}
+		}
+	}
+	//W4: a single separator between two numbers of the same type
+	for i := 1; i < n-1; i++ {
+		before, after := types[i-1], types[i+1]
+		switch {
+		case types[i] == bidi.ES && before == bidi.EN && after == bidi.EN,
+			types[i] == bidi.CS && before == bidi.EN && after == bidi.EN:
+			types[i] = bidi.EN
+		case types[i] == bidi.CS && before == bidi.AN && after == bidi.AN:
+			types[i] = bidi.AN
+		}
+	}
+	//W5: terminators next to European numbers
+	for i := 0; i < n; i++ {
+		if types[i] != bidi.ET {
+			continue
+		}
+		j := i
+		for j < n && types[j] == bidi.ET {
+			j++
+		}
+		if (i > 0 && types[i-1] == bidi.EN) || (j < n && types[j] == bidi.EN) {
+			for k := i; k < j; k++ {
+				types[k] = bidi.EN
+			}
+		}
+		i = j - 1
+	}
+	//W6, W7: the other separators are neutral, European numbers after left-to-right text are left-to-right
+	strong = sos
+	for i := range types {
+		switch types[i] {
+		case bidi.ES, bidi.ET, bidi.CS:
+			types[i] = bidi.ON
+		case bidi.L, bidi.R:
+			strong = types[i]
+		case bidi.EN:
+			if strong == bidi.L {
+				types[i] = bidi.L
+			}
+		}
+	}
+	bidiBrackets(units, types, sos)
+	//N1, N2: neutrals between text of the same direction take it, the others the direction of the paragraph
+	direction := func(class bidi.Class) bidi.Class {
+		if class == bidi.L {
+			return bidi.L
+		}
+		return bidi.R
+	}
+	for i := 0; i < n; i++ {
+		if !isBidiNeutral(types[i]) {
+			continue
+		}
+		j := i
+		for j < n && isBidiNeutral(types[j]) {
+			j++
+		}
+		before, after := sos, sos
+		if i > 0 {
+			before = direction(types[i-1])
+		}
+		if j < n {
+			after = direction(types[j])
+		}
+		resolved := sos
+		if before == after {
+			resolved = before
+		}
+		for k := i; k < j; k++ {
+			types[k] = resolved
+		}
+		i = j - 1
+	}
+	//I1, I2
+	levels := make([]int, n)
+	for i := range types {
+		levels[i] = base
+		switch {
+		case base == 0 && types[i] == bidi.R:
+			levels[i] = 1
+		case base == 0 && (types[i] == bidi.AN || types[i] == bidi.EN):
+			levels[i] = 2
+		case base == 1 && (types[i] == bidi.L || types[i] == bidi.AN || types[i] == bidi.EN):
+			levels[i] = 2
+		}
+	}
+	//L1: separators and the whitespaces before them or at the end of the line
+	trailing := true
+	for i := n - 1; i >= 0; i-- {
+		switch units[i].class {
+		case bidi.S, bidi.B:
+			levels[i] = base
+			trailing = true
+		case bidi.WS:
+			if trailing {
+				levels[i] = base
+			}
+		default:
+			trailing = false
+		}
+	}
+	return levels
+}
+
+// Resolve the paired brackets (rule N0): a pair takes the direction of the paragraph (sos) if the text inside
+// has it, otherwise the opposite direction if both the text inside and the text before have it
+func bidiBrackets(units []bidiUnit, types []bidi.Class, sos bidi.Class) {
+	type bracket struct {
+		closing rune
+		unit    int
+	}
+	strong := func(class bidi.Class) bidi.Class { //EN and AN are right-to-left
+		switch class {
+		case bidi.L:
+			return bidi.L
+		case bidi.R, bidi.AL, bidi.EN, bidi.AN:
+			return bidi.R
+		}
+		return bidi.ON
+	}
+	//BD16: the pairs, sorted by the opening bracket
+	pairs := make([][2]int, 0)
+	stack := make([]bracket, 0)
+pairing:
+	for i, unit := range units {
+		if unit.value == "" || types[i] != bidi.ON {
+			continue
+		}
+		r := []rune(unit.value)[0]
+		properties, _ := bidi.LookupRune(r)
+		switch {
+		case properties.IsOpeningBracket():
+			if len(stack) == 63 { //Too deep, the pairs found are kept
+				break pairing
+			}
+			stack = append(stack, bracket{closing: bidiMirror(r), unit: i})
+		case properties.IsBracket():
+			for k := len(stack) - 1; k >= 0; k-- {
+				if stack[k].closing == r {
+					pairs = append(pairs, [2]int{stack[k].unit, i})
+					stack = stack[:k]
+					break
+				}
+			}
+		}
+	}
+	sort.Slice(pairs, func(i, j int) bool { return pairs[i][0] < pairs[j][0] })
+	for _, pair := range pairs {
+		direction, inside := sos, bidi.ON
+		for k := pair[0] + 1; k < pair[1] && inside != sos; k++ {
+			if class := strong(types[k]); class != bidi.ON {
+				inside = class
+			}
+		}
+		if inside == bidi.ON {
+			continue
+		}
+		if inside != sos { //The context before the brackets
+			before := sos
+			for k := pair[0] - 1; k >= 0; k-- {
+				if class := strong(types[k]); class != bidi.ON {
+					before = class
+					break
+				}
+			}
+			direction = before
+		}
+		for _, k := range pair {
+			types[k] = direction
+			//The marks after the bracket take its direction
+			for j := k + 1; j < len(units) && units[j].class == bidi.NSM; j++ {
+				types[j] = direction
+			}
+		}
+	}
+}
+func isBidiNeutral(class bidi.Class) bool {
+	return class == bidi.ON || class == bidi.WS || class == bidi.S || class == bidi.B
+}
+
+// Return the indexes of the units in visual order (rule L2): from the highest level to the lowest odd level,
+// every sequence of units at that level or higher is reversed
+func bidiOrder(levels []int) []int {
+	order := make([]int, len(levels))
+	highest, lowestOdd := 0, 1<<30
+	for i, level := range levels {
+		order[i] = i
+		if level > highest {
+			highest = level
+		}
+		if level%2 == 1 && level < lowestOdd {
+			lowestOdd = level
+		}
+	}
+	for level := highest; level >= lowestOdd; level-- {
+		for i := 0; i < len(order); i++ {
+			if levels[order[i]] < level {
+				continue
+			}
+			j := i
+			for j < len(order) && levels[order[j]] >= level {
+				j++
+			}
+			for a, b := i, j-1; a < b; a, b = a+1, b-1 {
+				order[a], order[b] = order[b], order[a]
+			}
+			i = j
+		}
+	}
+	return order
+}
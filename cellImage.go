@@ -3,6 +3,7 @@ package reportengine
 import (
 	"bytes"
 	"encoding/base64"
+	"fmt"
 	"github.com/signintech/gopdf"
 	"image"
 	"log"
@@ -16,6 +17,8 @@ type CellImage struct {
 	valueBase64     string
 	dpi             float64
 	minMarginImg    Margin
+	originalValue   string
+	fallback        error //Why the value was replaced by ImgUnsupportedFormat
 
 	imgBytes       []byte
 	imgPixelWidth  int
@@ -32,11 +35,13 @@ func NewCellImage(horizontalAlign, verticalAlign uint, minMarginImg Margin, rect
 	ci.dpi = dpi
 	ci.minMarginImg = minMarginImg
 
+	ci.originalValue = valueBase64
 	err = ci.setValue(valueBase64)
 	if err != nil {
+		ci.fallback = err
 		err = ci.setValue(ImgUnsupportedFormat)
 		if err != nil {
-			panic(err.Error())
+			panic(newComponentError(ci, "NewCellImage", fmt.Errorf("%w: %v", ErrInvalidImage, err)))
 		}
 		ci.dpi = 450
 	}
@@ -45,17 +50,24 @@ func NewCellImage(horizontalAlign, verticalAlign uint, minMarginImg Margin, rect
 }
 
 func (t *CellImage) Build(pdf *gopdf.GoPdf, maxWidth float64) {
-	if maxWidth < t.MinWidth(pdf) {
-		panic("Width is not sufficient")
+	if err := t.checkBuild(pdf, maxWidth); err != nil {
+		panic(err)
 	}
 	t.rectangle.width = maxWidth
 	t.rectangle.height = t.MinHeight()
 	t.rectangle.lowerX = 0
 	t.rectangle.lowerY = 0
 }
+func (t *CellImage) checkBuild(pdf *gopdf.GoPdf, maxWidth float64) *ComponentError {
+	if maxWidth < t.MinWidth(pdf) {
+		return newComponentError(t, "Build", fmt.Errorf("%w: %.2f needed, %.2f available",
+			ErrInsufficientWidth, t.MinWidth(pdf), maxWidth))
+	}
+	return nil
+}
 func (t *CellImage) Adjust(pdf *gopdf.GoPdf, lowerX, lowerY, width, height float64) {
-	if t.MinWidth(pdf) > width || t.MinHeight() > height {
-		panic("Width/Height are not sufficient")
+	if err := checkSpace(t, pdf, width, height); err != nil {
+		panic(err)
 	}
 	t.rectangle.lowerX = lowerX
 	t.rectangle.lowerY = lowerY
@@ -78,19 +90,18 @@ func (t CellImage) MinWidth(*gopdf.GoPdf) float64 {
 func (t CellImage) MinHeight() float64 {
 	return t.imgHeight() + t.minMarginImg.top + t.minMarginImg.bottom
 }
-func (t CellImage) Render(pdf *gopdf.GoPdf) {
+func (t *CellImage) Render(pdf *gopdf.GoPdf) {
 	t.rectangle.Render(pdf)
 	lowerX, upperY := t.getImgStartPosition()
 	imgH1, err := gopdf.ImageHolderByBytes(t.imgBytes)
 	if err != nil {
-		log.Fatal(err.Error())
-		return
+		panic(newComponentError(t, "Render", fmt.Errorf("%w: %v", ErrInvalidImage, err)))
 	}
 	h := t.imgHeight()
 	w := t.imgWidth()
 	err = pdf.ImageByHolder(imgH1, lowerX, upperY-h, &gopdf.Rect{H: h, W: w})
 	if err != nil {
-		log.Fatal(err.Error())
+		panic(newComponentError(t, "Render", fmt.Errorf("%w: %v", ErrInvalidImage, err)))
 	}
 }
 func (t CellImage) FirstVoidSpace() Rectangle {
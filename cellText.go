@@ -2,7 +2,7 @@ package reportengine
 
 import (
 	"github.com/signintech/gopdf"
-	"regexp"
+	"math"
 	"strconv"
 	"strings"
 )
@@ -15,9 +15,31 @@ type CellText struct {
 	fontSize        int
 	color           Color
 	fontFamily      string
+	fontWeight      int
+	italic          bool
 	originalValue   string
+	originalTokens  []Token
 	minMarginText   Margin
 	tokens          []Token
+	pageContext     PageContext
+	gapExtra        float64 //Width added to every gap token (Justify)
+	newlines        int     //Newlines before the text, for the paragraphs of a CellTextArea (SetKeepNewlines)
+	overflow        int
+	minFontSize     int
+	wrapped         *CellTextArea //The text on more lines (OverflowWrap)
+	truncations     []Diagnostic  //Changes of the value made by the last Build
+	rotation        float64       //Degrees, counterclockwise
+	builtTextWidth  float64       //Width of the text measured by Build, for MinHeight of a rotated text
+	rtl             bool          //Right-to-left paragraph, the tokens are reordered when rendered
+	glued           bool          //Part of the word before it, joined without a space (CellTextArea)
+	fontFallbacks   []string
+	kerning         bool
+	ligatures       bool
+	fontMetrics     bool
+	letterSpacing   float64
+	wordSpacing     float64
+	textTransform   int
+	markup          bool //The value is parsed as markup (SetMarkup)
 }
 
 func NewCellText(horizontalAlign uint, verticalAlign uint, value string, underline bool,
@@ -31,56 +53,152 @@ func NewCellText(horizontalAlign uint, verticalAlign uint, value string, underli
 	ct.originalValue = value
 	ct.minMarginText = minMarginText
 	ct.fontFamily = fontFamily
-	ct.setTokens(value, fontFamily, fontSize, color)
+	ct.setTokens()
 	ct.rectangle = rectangle
 	return ct
 }
 
+// SetFontWeight sets the weight of the font (FontWeightRegular, FontWeightBold, ...), the font of the family
+// with the nearest weight is used
+func (t *CellText) SetFontWeight(weight int) {
+	t.fontWeight = weight
+	t.setTokens()
+}
+
+// SetItalic sets the italic font of the family, if it has one
+func (t *CellText) SetItalic(italic bool) {
+	t.italic = italic
+	t.setTokens()
+}
+
+// SetFontFallbacks sets the families used, in order, for the characters missing from the font
+// (for example "€" or Cyrillic in a decorative font)
+func (t *CellText) SetFontFallbacks(families ...string) {
+	t.fontFallbacks = families
+	t.setTokens()
+}
+
+// SetKerning uses the kerning of the font (GPOS or kern table) to measure and write the text
+func (t *CellText) SetKerning(kerning bool) {
+	t.kerning = kerning
+	t.setTokens()
+}
+
+// SetLigatures uses the standard ligatures of the font (GSUB), like "fi" and "fl"
+func (t *CellText) SetLigatures(ligatures bool) {
+	t.ligatures = ligatures
+	t.setTokens()
+}
+
+// SetFontMetrics positions the text with the ascender, the descender and the line gap of the font instead of
+// the height of gopdf: the text is as high as its tallest font and the tokens of different sizes share the baseline
+func (t *CellText) SetFontMetrics(fontMetrics bool) {
+	t.fontMetrics = fontMetrics
+	t.setTokens()
+}
+
+// SetLetterSpacing adds points after every character (tracking), negative values tighten the text
+func (t *CellText) SetLetterSpacing(points float64) {
+	t.letterSpacing = points
+	t.setTokens()
+}
+
+// SetWordSpacing adds points after every space
+func (t *CellText) SetWordSpacing(points float64) {
+	t.wordSpacing = points
+	t.setTokens()
+}
+
+// SetTextTransform changes the case of the text when it is measured and written
+// (TextTransformUppercase, TextTransformSmallCaps, ...), the value is not changed
+func (t *CellText) SetTextTransform(transform int) {
+	t.textTransform = transform
+	t.setTokens()
+}
+
+// SetMarkup parses the value as markup: **bold**, __italic__, c{#FF0000;color}, ... (see the README).
+// Without markup the value is written as it is, but for the icons and the fields.
+func (t *CellText) SetMarkup(markup bool) {
+	t.markup = markup
+	t.setTokens()
+}
+
+// SetRotation rotates the text counterclockwise by degrees (90 for a text read from the bottom up).
+// The cell takes the bounding box of the rotated text, OverflowWrap is OverflowEllipsisEnd for a rotated text.
+func (t *CellText) SetRotation(degrees float64) {
+	t.rotation = degrees
+}
+
 func (t *CellText) Build(pdf *gopdf.GoPdf, maxWidth float64) {
 	//To reset previous Shorten called
 	t.toOriginal()
-	for range t.tokens {
-		minWidth := t.MinWidth(pdf)
-		if minWidth <= maxWidth {
-			break
-		}
-		index := len(t.tokens) - 1
-		tokenWidth := t.tokens[index].Width(pdf)
-		if tokenWidth > minWidth-maxWidth && t.tokens[index].fontFamily != IconFontFamily {
-			if t.tokens[index].Shorten(pdf, tokenWidth-minWidth+maxWidth) {
-				break
-			}
-		}
-		t.tokens = t.tokens[:len(t.tokens)-1]
+	t.gapExtra = 0
+	t.wrapped = nil
+	t.truncations = nil
+	if t.MinWidth(pdf) > maxWidth {
+		t.fit(pdf, maxWidth)
 	}
+	t.builtTextWidth = t.textWidth(pdf)
 	t.rectangle.width = maxWidth
 	t.rectangle.height = t.MinHeight()
 	t.rectangle.lowerX = 0
 	t.rectangle.lowerY = 0
 }
 func (t *CellText) Adjust(pdf *gopdf.GoPdf, lowerX, lowerY, width, height float64) {
-	if t.MinWidth(pdf) > width || t.MinHeight() > height {
-		panic("Width/Height are not sufficient")
+	if err := checkSpace(t, pdf, width, height); err != nil {
+		panic(err)
 	}
 	t.rectangle.lowerX = lowerX
 	t.rectangle.lowerY = lowerY
 	t.rectangle.width = width
 	t.rectangle.height = height
+	if t.wrapped != nil {
+		t.wrapped.Adjust(pdf, lowerX, lowerY, width, height)
+	}
 }
 func (t *CellText) MoveTo(lowerX, lowerY float64) {
 	t.rectangle.lowerX = lowerX
 	t.rectangle.lowerY = lowerY
+	if t.wrapped != nil {
+		t.wrapped.MoveTo(lowerX, lowerY)
+	}
 }
 func (t *CellText) SetVisibilityContainer(isVisible bool) {
 	t.rectangle.isVisible = isVisible
+	if t.wrapped != nil {
+		t.wrapped.SetVisibilityContainer(isVisible)
+	}
 }
 func (t *CellText) Split(*gopdf.GoPdf, float64, int) Component {
 	return nil
 }
+func (t *CellText) SetPageContext(context PageContext) {
+	t.pageContext = context
+	for i := range t.tokens {
+		t.tokens[i].context = context
+	}
+	if t.wrapped != nil {
+		t.wrapped.SetPageContext(context)
+	}
+}
 func (t CellText) MinWidth(pdf *gopdf.GoPdf) float64 {
+	if t.wrapped != nil {
+		return t.wrapped.MinWidth(pdf)
+	}
+	if t.rotation != 0 {
+		width, _ := t.rotatedBox(t.textWidth(pdf))
+		return width + t.minMarginText.left + t.minMarginText.right
+	}
 	return t.textWidth(pdf) + t.minMarginText.left + t.minMarginText.right
 }
 func (t CellText) MinHeight() float64 {
+	if t.wrapped != nil {
+		return t.wrapped.MinHeight()
+	}
+	if t.rotation != 0 {
+		_, height := t.rotatedBox(t.builtTextWidth)
+		return height + t.minMarginText.top + t.minMarginText.bottom
+	}
 	temp := t.textHeight() + t.minMarginText.top + t.minMarginText.bottom
 	if t.underline {
 		return temp + (float64(t.fontSize) * UnderlineWidthFactor) + UnderlineMargin
@@ -88,7 +206,15 @@ func (t CellText) MinHeight() float64 {
 	return temp
 }
 func (t CellText) Render(pdf *gopdf.GoPdf) {
+	if t.wrapped != nil {
+		t.wrapped.Render(pdf)
+		return
+	}
 	t.rectangle.Render(pdf)
+	if t.rotation != 0 {
+		t.renderRotated(pdf)
+		return
+	}
 	t.renderTokens(pdf)
 }
 func (t CellText) FirstVoidSpace() Rectangle {
@@ -108,51 +234,74 @@ func (t CellText) IsSplittable() bool {
 }
 
 func (t *CellText) toOriginal() {
-	t.setTokens(t.originalValue, t.fontFamily, t.fontSize, t.color)
-}
-func (t *CellText) setTokens(value string, fontFamily string, fontSize int, color Color) {
-	regexIcon := regexp.MustCompile(`i{(0x[a-zA-Z0-9]{4,5};#[a-zA-Z0-9]{6})}`)
-	icons := regexIcon.FindAllStringSubmatch(value, -1)
-	temp := value
-	t.tokens = make([]Token, 0)
-	for _, val := range icons {
-		if temp != "" {
-			x := strings.Split(temp, val[0])
-			temp = x[1]
-			if x[0] != "" {
-				t.tokens = append(t.tokens, Token{fontFamily: fontFamily, fontSize: fontSize, value: x[0], color: color})
-			}
-		}
-		x, y := getIcon(val[1])
-		t.tokens = append(t.tokens, Token{fontFamily: IconFontFamily, fontSize: fontSize, value: x, color: y})
-	}
-	if temp != "" {
-		t.tokens = append(t.tokens, Token{fontFamily: fontFamily, fontSize: fontSize, value: temp, color: color})
+	t.tokens = make([]Token, len(t.originalTokens))
+	for i := range t.originalTokens {
+		t.tokens[i] = t.originalTokens[i]
+		t.tokens[i].context = t.pageContext
 	}
+}
+
+// Return the font of the text, resolved from family, weight and style
+func (t CellText) textFontFamily() string {
+	return resolveFontFamily(t.fontFamily, t.fontWeight, t.italic)
+}
+func (t CellText) style() textStyle {
+	return textStyle{fontFamily: t.fontFamily, fontWeight: t.fontWeight, italic: t.italic, fontSize: t.fontSize,
+		color: t.color, fallbacks: t.fontFallbacks, kerning: t.kerning, ligatures: t.ligatures,
+		fontMetrics: t.fontMetrics, letterSpacing: t.letterSpacing, wordSpacing: t.wordSpacing, transform: t.textTransform}
+}
 
+// Parse the markup of the value (see parseMarkup)
+func (t *CellText) setTokens() {
+	t.originalTokens = shapeArabic(parseMarkup(t.originalValue, t.style(), t.markup))
+	t.rtl = isRightToLeft(t.originalTokens)
+	t.toOriginal()
 }
 func (t CellText) textWidth(pdf *gopdf.GoPdf) float64 {
 	tot := 0.0
-	for i := range t.tokens {
-		tot += t.tokens[i].Width(pdf)
+	for _, token := range visualTokens(t.tokens, t.rtl) { //The tokens rendered, for the kerning
+		tot += t.tokenWidth(pdf, token)
 	}
 	return tot
 }
-func (t CellText) textHeight() float64 {
-	max := 0.0
+func (t CellText) tokenWidth(pdf *gopdf.GoPdf, token Token) float64 {
+	if token.gap {
+		return token.Width(pdf) + t.gapExtra
+	}
+	return token.Width(pdf)
+}
+func (t CellText) gaps() int {
+	n := 0
 	for i := range t.tokens {
-		temp := t.tokens[i].Height()
-		if temp > max {
-			max = temp
+		if t.tokens[i].gap {
+			n++
 		}
 	}
-	return max
+	return n
+}
+func (t CellText) textHeight() float64 {
+	ascent, descent := t.textMetrics()
+	return ascent + descent
 }
+
+// Return the highest ascent and descent of the tokens: they are written on a common baseline,
+// so the text goes from the highest ascender to the lowest descender
+func (t CellText) textMetrics() (ascent, descent float64) {
+	for i := range t.tokens {
+		a, d, _ := t.tokens[i].metrics()
+		ascent = math.Max(ascent, a)
+		descent = math.Max(descent, d)
+	}
+	return ascent, descent
+}
+
+// Return the position of the start of the baseline of the text
 func (t CellText) getTextStartPosition(pdf *gopdf.GoPdf) (x float64, y float64) {
 	textWidth := t.textWidth(pdf)
-	textHeight := t.textHeight()
+	ascent, descent := t.textMetrics()
+	textHeight := ascent + descent
 	switch t.horizontalAlign {
-	case gopdf.Left:
+	case gopdf.Left, Justify:
 		x = t.rectangle.lowerX + t.minMarginText.left
 	case gopdf.Right:
 		x = t.rectangle.lowerX + t.rectangle.width - t.minMarginText.right - textWidth
@@ -165,20 +314,20 @@ func (t CellText) getTextStartPosition(pdf *gopdf.GoPdf) (x float64, y float64)
 	}
 	switch t.verticalAlign {
 	case gopdf.Top:
-		y = t.rectangle.lowerY + textHeight + t.minMarginText.top
+		y = t.rectangle.lowerY + t.minMarginText.top + ascent
 	case gopdf.Middle:
-		y = t.rectangle.lowerY + t.rectangle.height - underlineMargin - (t.rectangle.height-textHeight-underlineMargin)/2.0
+		y = t.rectangle.lowerY + (t.rectangle.height-textHeight-underlineMargin)/2.0 + ascent
 	case gopdf.Bottom:
-		y = t.rectangle.lowerY + t.rectangle.height - t.minMarginText.bottom - underlineMargin
+		y = t.rectangle.lowerY + t.rectangle.height - t.minMarginText.bottom - underlineMargin - descent
 	}
 	return x, y
 }
 func (t CellText) renderTokens(pdf *gopdf.GoPdf) {
 	lowerX, upperY := t.getTextStartPosition(pdf)
 	startX := lowerX
-	for i := range t.tokens {
-		t.tokens[i].Render(pdf, lowerX, upperY)
-		lowerX += t.tokens[i].Width(pdf)
+	for _, token := range visualTokens(t.tokens, t.rtl) {
+		token.Render(pdf, lowerX, upperY)
+		lowerX += t.tokenWidth(pdf, token)
 	}
 	if t.underline {
 		pdf.SetLineWidth(float64(t.fontSize) * UnderlineWidthFactor)
@@ -187,6 +336,76 @@ func (t CellText) renderTokens(pdf *gopdf.GoPdf) {
 		pdf.Line(startX, upperY+UnderlineMargin+(float64(t.fontSize)*UnderlineWidthFactor), lowerX, upperY+UnderlineMargin+(float64(t.fontSize)*UnderlineWidthFactor))
 	}
 }
+
+// Return the height of the text with the underline
+func (t CellText) textBoxHeight() float64 {
+	if t.underline {
+		return t.textHeight() + float64(t.fontSize)*UnderlineWidthFactor + UnderlineMargin
+	}
+	return t.textHeight()
+}
+
+// Return the size of the bounding box of the text long textWidth, rotated
+func (t CellText) rotatedBox(textWidth float64) (width, height float64) {
+	angle := t.rotation * math.Pi / 180
+	sin, cos := math.Abs(math.Sin(angle)), math.Abs(math.Cos(angle))
+	return textWidth*cos + t.textBoxHeight()*sin, textWidth*sin + t.textBoxHeight()*cos
+}
+
+// Return the width available to the text in the width of the cell, with the rotation
+func (t CellText) textSpace(width float64) float64 {
+	width -= t.minMarginText.left + t.minMarginText.right
+	if t.rotation == 0 {
+		return width
+	}
+	angle := t.rotation * math.Pi / 180
+	sin, cos := math.Abs(math.Sin(angle)), math.Abs(math.Cos(angle))
+	if cos < 1e-9 { //Vertical, the length of the text doesn't take width
+		return math.Inf(1)
+	}
+	return (width - t.textBoxHeight()*sin) / cos
+}
+
+// Render the text rotated around the center of its bounding box, aligned in the cell
+func (t CellText) renderRotated(pdf *gopdf.GoPdf) {
+	textWidth := t.textWidth(pdf)
+	width, height := t.rotatedBox(textWidth)
+	var x, y float64
+	switch t.horizontalAlign {
+	case gopdf.Left, Justify:
+		x = t.rectangle.lowerX + t.minMarginText.left
+	case gopdf.Right:
+		x = t.rectangle.lowerX + t.rectangle.width - t.minMarginText.right - width
+	case gopdf.Center:
+		x = t.rectangle.lowerX + (t.rectangle.width-width)/2.0
+	}
+	switch t.verticalAlign {
+	case gopdf.Top:
+		y = t.rectangle.lowerY + t.minMarginText.top
+	case gopdf.Middle:
+		y = t.rectangle.lowerY + (t.rectangle.height-height)/2.0
+	case gopdf.Bottom:
+		y = t.rectangle.lowerY + t.rectangle.height - t.minMarginText.bottom - height
+	}
+	centerX, centerY := x+width/2, y+height/2
+	//The text not rotated, with the same center
+	text := t
+	text.rotation = 0
+	text.horizontalAlign = gopdf.Left
+	text.verticalAlign = gopdf.Top
+	text.minMarginText = NewMargin(0)
+	text.rectangle.lowerX = centerX - textWidth/2
+	text.rectangle.lowerY = centerY - t.textBoxHeight()/2
+	pdf.Rotate(t.rotation, centerX, centerY)
+	text.renderTokens(pdf)
+	pdf.RotateReset()
+}
+
+// Return the token written between two words merged on a line, with the spacing of the text
+func (t CellText) gapToken(delimiter string) Token {
+	return Token{fontFamily: t.textFontFamily(), fontSize: t.fontSize, value: delimiter, color: t.color,
+		gap: true, fontMetrics: t.fontMetrics, letterSpacing: t.letterSpacing, wordSpacing: t.wordSpacing}
+}
 func merge(a CellText, b CellText, delimiter string) (res CellText) {
 	res.horizontalAlign = a.horizontalAlign
 	res.verticalAlign = a.verticalAlign
@@ -195,12 +414,32 @@ func merge(a CellText, b CellText, delimiter string) (res CellText) {
 	res.fontSize = a.fontSize
 	res.color = a.color
 	res.fontFamily = a.fontFamily
+	res.fontWeight = a.fontWeight
+	res.italic = a.italic
+	res.fontFallbacks = a.fontFallbacks
+	res.kerning = a.kerning
+	res.ligatures = a.ligatures
+	res.fontMetrics = a.fontMetrics
+	res.letterSpacing = a.letterSpacing
+	res.wordSpacing = a.wordSpacing
+	res.textTransform = a.textTransform
 	res.originalValue = a.originalValue + delimiter + b.originalValue
 	res.minMarginText = a.minMarginText
+	res.pageContext = a.pageContext
+	res.newlines = a.newlines
+	res.rtl = a.rtl
+	res.glued = a.glued
 	res.tokens = make([]Token, 0)
 	res.tokens = append(res.tokens, a.tokens...)
-	res.tokens = append(res.tokens, Token{fontFamily: res.fontFamily, fontSize: res.fontSize, value: delimiter, color: res.color})
+	res.originalTokens = make([]Token, 0, len(a.originalTokens)+len(b.originalTokens)+1)
+	res.originalTokens = append(res.originalTokens, a.originalTokens...)
+	if delimiter != "" { //No gap between the parts of a word
+		gap := res.gapToken(delimiter)
+		res.tokens = append(res.tokens, gap)
+		res.originalTokens = append(res.originalTokens, gap)
+	}
 	res.tokens = append(res.tokens, b.tokens...)
+	res.originalTokens = append(res.originalTokens, b.originalTokens...)
 	return res
 }
 func getIcon(value string) (icon string, color Color) {
@@ -2,8 +2,6 @@ package reportengine
 
 import (
 	"github.com/signintech/gopdf"
-	"regexp"
-	"strings"
 )
 
 type CellTextArea struct {
@@ -13,11 +11,31 @@ type CellTextArea struct {
 	underline       bool
 	fontSize        int
 	fontFamily      string
+	fontWeight      int
+	italic          bool
+	fontFallbacks   []string
+	kerning         bool
+	ligatures       bool
+	fontMetrics     bool
+	letterSpacing   float64
+	wordSpacing     float64
+	textTransform   int
+	markup          bool //The value is parsed as markup (SetMarkup)
 	color           Color
 	originalValue   string
 	minMarginText   Margin
 	cellsText       []CellText
+	cellsTextBroken []CellText //cellsText with the words broken by Build (SetWrap, SetHyphenation)
 	cellsTextMerged []CellText
+	lineStart       []int //Index of the first cellText of cellsTextBroken of every line of cellsTextMerged
+	wrap            int
+	lineHeight      float64
+	paragraphSpace  float64
+	keepNewlines    bool
+	hyphenator      *hyphenator
+	orphans         int
+	widows          int
+	continues       bool //The paragraph of the last line continues in the next text area (Split), so it is justified
 }
 
 func NewCellTextArea(horizontalAlign uint, verticalAlign uint, value string, underline bool,
@@ -31,24 +49,207 @@ func NewCellTextArea(horizontalAlign uint, verticalAlign uint, value string, und
 	cta.originalValue = value
 	cta.minMarginText = minMarginText
 	cta.fontFamily = fontFamily
-	regexSpaces := regexp.MustCompile(`\s+`)
-	str := strings.TrimSpace(regexSpaces.ReplaceAllString(value, " "))
-	cta.cellsText = make([]CellText, 0)
-	for _, val := range strings.Split(str, " ") {
-		margin := gopdf.ContentObjCalTextHeight(fontSize) * 0.58
-		if underline {
-			margin = margin - float64(fontSize)*UnderlineWidthFactor - UnderlineMargin
+	cta.rectangle = rectangle
+	cta.lineHeight = DefaultLineHeight
+	cta.setCellsText()
+	cta.orphans = 1
+	cta.widows = 1
+	return cta
+}
+
+// SetOrphans sets the minimum number of lines left at the bottom of a page when the text area is split.
+func (t *CellTextArea) SetOrphans(orphans int) {
+	t.orphans = orphans
+}
+
+// SetWidows sets the minimum number of lines carried to the next page when the text area is split.
+func (t *CellTextArea) SetWidows(widows int) {
+	t.widows = widows
+}
+
+// SetFontWeight sets the weight of the font (FontWeightRegular, FontWeightBold, ...), the font of the family
+// with the nearest weight is used
+func (t *CellTextArea) SetFontWeight(weight int) {
+	t.fontWeight = weight
+	t.setCellsText()
+}
+
+// SetItalic sets the italic font of the family, if it has one
+func (t *CellTextArea) SetItalic(italic bool) {
+	t.italic = italic
+	t.setCellsText()
+}
+
+// SetFontFallbacks sets the families used, in order, for the characters missing from the font
+func (t *CellTextArea) SetFontFallbacks(families ...string) {
+	t.fontFallbacks = families
+	t.setCellsText()
+}
+
+// SetKerning uses the kerning of the font (GPOS or kern table) to measure and write the text
+func (t *CellTextArea) SetKerning(kerning bool) {
+	t.kerning = kerning
+	t.setCellsText()
+}
+
+// SetLigatures uses the standard ligatures of the font (GSUB), like "fi" and "fl"
+func (t *CellTextArea) SetLigatures(ligatures bool) {
+	t.ligatures = ligatures
+	t.setCellsText()
+}
+
+// SetFontMetrics positions the text with the ascender, the descender and the line gap of the font (see
+// CellText.SetFontMetrics), the line spacing is the sum of them: SetLineHeight(1) for the spacing of the font
+func (t *CellTextArea) SetFontMetrics(fontMetrics bool) {
+	t.fontMetrics = fontMetrics
+	t.setCellsText()
+}
+
+// SetLetterSpacing adds points after every character (tracking), negative values tighten the text
+func (t *CellTextArea) SetLetterSpacing(points float64) {
+	t.letterSpacing = points
+	t.setCellsText()
+}
+
+// SetWordSpacing adds points after every space
+func (t *CellTextArea) SetWordSpacing(points float64) {
+	t.wordSpacing = points
+	t.setCellsText()
+}
+
+// SetTextTransform changes the case of the text when it is measured and written
+// (TextTransformUppercase, TextTransformSmallCaps, ...), the value is not changed
+func (t *CellTextArea) SetTextTransform(transform int) {
+	t.textTransform = transform
+	t.setCellsText()
+}
+
+// SetMarkup parses the value as markup: **bold**, __italic__, c{#FF0000;color}, ... (see the README).
+// Without markup the value is written as it is, but for the icons and the fields.
+func (t *CellTextArea) SetMarkup(markup bool) {
+	t.markup = markup
+	t.setCellsText()
+}
+
+// SetLineHeight sets the height of the lines as a multiple of the line spacing of the font, the height of the
+// text or with SetFontMetrics the sum of the ascender, the descender and the line gap (DefaultLineHeight)
+func (t *CellTextArea) SetLineHeight(lineHeight float64) {
+	t.lineHeight = lineHeight
+	t.setCellsText()
+}
+
+// SetParagraphSpacing sets the space between the paragraphs (SetKeepNewlines)
+func (t *CellTextArea) SetParagraphSpacing(spacing float64) {
+	t.paragraphSpace = spacing
+}
+
+// SetKeepNewlines keeps the newlines of the text: every newline starts a new paragraph and every blank line
+// is kept as an empty line. Otherwise the newlines are spaces, like the other whitespaces.
+func (t *CellTextArea) SetKeepNewlines(keep bool) {
+	t.keepNewlines = keep
+	t.setCellsText()
+}
+
+// Parse the markup of the value (see parseMarkup) and split it in words, a cellText for every word.
+// The markup can span more words.
+func (t *CellTextArea) setCellsText() {
+	style := textStyle{fontFamily: t.fontFamily, fontWeight: t.fontWeight, italic: t.italic, fontSize: t.fontSize,
+		color: t.color, fallbacks: t.fontFallbacks, kerning: t.kerning, ligatures: t.ligatures,
+		fontMetrics: t.fontMetrics, letterSpacing: t.letterSpacing, wordSpacing: t.wordSpacing, transform: t.textTransform}
+	//The space between the baselines of the lines, less the height of the text
+	margin := t.lineSpacing()*t.lineHeight - style.token().Height()
+	if t.underline {
+		margin = margin - float64(t.fontSize)*UnderlineWidthFactor - UnderlineMargin
+	}
+	if margin < 0 {
+		margin = 0
+	}
+	margin /= 2.0
+	words, newlines := splitWords(shapeArabic(parseMarkup(t.originalValue, style, t.markup)))
+	if len(words) == 0 {
+		words = append(words, []Token{})
+		newlines = append(newlines, 0)
+	}
+	t.cellsText = make([]CellText, 0, len(words))
+	rtl := false
+	for i, word := range words {
+		if i == 0 || t.keepNewlines && newlines[i] > 0 { //Direction of the paragraph
+			rtl = t.isRightToLeftParagraph(words[i:], newlines[i:])
 		}
-		if margin < 0 {
-			margin = 0
+		horizontalAlign := t.horizontalAlign
+		if horizontalAlign == Justify { //The lines are justified by the text area
+			horizontalAlign = gopdf.Left
+		}
+		if rtl && horizontalAlign == gopdf.Left { //Aligned to the start of the paragraph
+			horizontalAlign = gopdf.Right
+		}
+		for k, segment := range lineBreakSegments(word) { //The lines can be broken also inside the words
+			//Aligned top, the lines are aligned by the text area and the space of the paragraphs is above the text
+			ct := *NewCellText(horizontalAlign, gopdf.Top, "", t.underline, t.fontFamily,
+				t.fontSize, t.color, NewVerticalMargin(margin), t.rectangle)
+			ct.fontWeight = t.fontWeight
+			ct.italic = t.italic
+			ct.fontFallbacks = t.fontFallbacks
+			ct.kerning = t.kerning
+			ct.ligatures = t.ligatures
+			ct.fontMetrics = t.fontMetrics
+			ct.letterSpacing = t.letterSpacing
+			ct.wordSpacing = t.wordSpacing
+			ct.textTransform = t.textTransform
+			if t.keepNewlines && k == 0 {
+				ct.newlines = newlines[i]
+			}
+			ct.glued = k > 0
+			ct.rtl = rtl
+			for _, token := range segment {
+				ct.originalValue += token.value
+			}
+			ct.originalTokens = segment
+			ct.toOriginal()
+			t.cellsText = append(t.cellsText, ct)
 		}
-		margin /= 2.0
-		ct := *NewCellText(horizontalAlign, verticalAlign, val, underline, fontFamily,
-			fontSize, color, NewVerticalMargin(margin), rectangle)
-		cta.cellsText = append(cta.cellsText, ct)
 	}
-	cta.rectangle = rectangle
-	return cta
+}
+
+// Return the line spacing of the font: the height of the text, or the ascender, the descender and the line gap
+func (t CellTextArea) lineSpacing() float64 {
+	ascent, descent, lineGap := textStyle{fontFamily: t.fontFamily, fontWeight: t.fontWeight, italic: t.italic,
+		fontSize: t.fontSize, fontMetrics: t.fontMetrics}.token().metrics()
+	return ascent + descent + lineGap
+}
+
+// Return true if the paragraph that starts with the first word is right-to-left
+func (t CellTextArea) isRightToLeftParagraph(words [][]Token, newlines []int) bool {
+	tokens := make([]Token, 0)
+	for i := range words {
+		if i > 0 && t.keepNewlines && newlines[i] > 0 {
+			break
+		}
+		tokens = append(tokens, words[i]...)
+	}
+	return isRightToLeft(tokens)
+}
+
+// SetWrap sets how the words wider than the text area are wrapped:
+//   - WrapWord: the word is shortened with ShortenCharacters (default)
+//   - WrapBreakWord: the word is broken across more lines (at a hyphenation point if SetHyphenation is used)
+func (t *CellTextArea) SetWrap(wrap int) {
+	t.wrap = wrap
+}
+
+// SetHyphenation hyphenates the words at the end of the lines with the patterns of language
+// (HyphenationItalian, HyphenationEnglish), an empty language disables the hyphenation.
+func (t *CellTextArea) SetHyphenation(language string) error {
+	if language == "" {
+		t.hyphenator = nil
+		return nil
+	}
+	h, err := getHyphenator(language)
+	if err != nil {
+		return err
+	}
+	t.hyphenator = h
+	return nil
 }
 
 func (t *CellTextArea) Build(pdf *gopdf.GoPdf, maxWidth float64) {
@@ -57,15 +258,43 @@ func (t *CellTextArea) Build(pdf *gopdf.GoPdf, maxWidth float64) {
 	for i = 0; i < len(t.cellsText); i++ {
 		t.cellsText[i].toOriginal()
 	}
+	words := t.cellsText
+	if t.wrap != WrapWord || t.hyphenator != nil { //The words broken are added only for this width
+		words = append([]CellText{}, t.cellsText...)
+	}
+	lineWidth := maxWidth - t.minMarginText.left - t.minMarginText.right
 	t.cellsTextMerged = make([]CellText, 0)
-	for i = 0; i < len(t.cellsText); i++ {
-		nct := t.cellsText[i]
-		for j = i + 1; j < len(t.cellsText); j++ {
-			mergedWidth := nct.MinWidth(pdf) + t.cellsText[j].MinWidth(pdf) +
-				t.minMarginText.left + t.minMarginText.right + Width(pdf, t.fontFamily, t.fontSize, " ")
+	t.lineStart = make([]int, 0)
+	for i = 0; i < len(words); i++ {
+		t.lineStart = append(t.lineStart, i)
+		if words[i].MinWidth(pdf) > lineWidth { //Word wider than the line
+			if first, rest, ok := t.breakWord(pdf, words[i], lineWidth, t.wrap == WrapBreakWord); ok {
+				words[i] = first
+				words = insertCellText(words, rest, i+1)
+			}
+		}
+		nct := words[i]
+		for j = i + 1; j < len(words) && words[j].newlines == 0; j++ {
+			//The gap as written by merge, with the letter and word spacing
+			delimiter, delimiterWidth := " ", nct.gapToken(" ").Width(pdf)
+			if words[j].glued { //Part of the same word
+				delimiter, delimiterWidth = "", 0
+			}
+			mergedWidth := nct.MinWidth(pdf) + words[j].MinWidth(pdf) + t.minMarginText.left +
+				t.minMarginText.right + delimiterWidth
 			if mergedWidth <= maxWidth { //Merge
-				nct = merge(nct, t.cellsText[j], " ")
+				nct = merge(nct, words[j], delimiter)
 			} else {
+				//Hyphenate the word in the space left
+				space := lineWidth - nct.MinWidth(pdf) - delimiterWidth
+				if t.hyphenator != nil {
+					if first, rest, ok := t.breakWord(pdf, words[j], space, false); ok {
+						words[j] = first
+						words = insertCellText(words, rest, j+1)
+						nct = merge(nct, words[j], delimiter)
+						j++
+					}
+				}
 				break
 			}
 		}
@@ -73,9 +302,12 @@ func (t *CellTextArea) Build(pdf *gopdf.GoPdf, maxWidth float64) {
 
 		i = j - 1
 	}
+	t.cellsTextBroken = words
 	startY := t.minMarginText.top
 	for i := 0; i < len(t.cellsTextMerged); i++ {
 		t.cellsTextMerged[i].Build(pdf, maxWidth-t.minMarginText.left-t.minMarginText.right)
+		t.cellsTextMerged[i].minMarginText.top += t.paragraphSpacing(i)
+		t.cellsTextMerged[i].rectangle.height = t.cellsTextMerged[i].MinHeight()
 		if i > 0 {
 			startY += t.cellsTextMerged[i-1].rectangle.height
 		}
@@ -88,8 +320,8 @@ func (t *CellTextArea) Build(pdf *gopdf.GoPdf, maxWidth float64) {
 	t.rectangle.lowerY = 0
 }
 func (t *CellTextArea) Adjust(pdf *gopdf.GoPdf, lowerX, lowerY, width, height float64) {
-	if t.MinWidth(pdf) > width || t.MinHeight() > height {
-		panic("Width/Height are not sufficient")
+	if err := checkSpace(t, pdf, width, height); err != nil {
+		panic(err)
 	}
 	t.rectangle.lowerX = lowerX
 	t.rectangle.lowerY = lowerY
@@ -97,6 +329,13 @@ func (t *CellTextArea) Adjust(pdf *gopdf.GoPdf, lowerX, lowerY, width, height fl
 	t.rectangle.height = height
 	x, y := t.getCellTextStartPosition(pdf)
 	w := t.cellWidth(pdf)
+	if t.horizontalAlign == Justify || t.horizontalAlign == gopdf.Left {
+		//Full width, so the right-to-left paragraphs are aligned right
+		w = width - t.minMarginText.left - t.minMarginText.right
+	}
+	if t.horizontalAlign == Justify {
+		t.justify(pdf, w)
+	}
 	for i := 0; i < len(t.cellsTextMerged); i++ {
 		h := t.cellsTextMerged[i].MinHeight()
 		t.cellsTextMerged[i].Adjust(pdf, x, y, w, h)
@@ -117,8 +356,46 @@ func (t *CellTextArea) MoveTo(lowerX, lowerY float64) {
 func (t *CellTextArea) SetVisibilityContainer(isVisible bool) {
 	t.rectangle.isVisible = isVisible
 }
-func (t *CellTextArea) Split(*gopdf.GoPdf, float64, int) Component {
-	return nil
+
+// Split keeps in this text area the lines that fit in firstHeight and returns a new text area with the others,
+// respecting the minimum number of orphan and widow lines.
+func (t *CellTextArea) Split(pdf *gopdf.GoPdf, firstHeight float64, _ int) Component {
+	var lines int
+	height := t.minMarginText.top + t.minMarginText.bottom
+	for lines = 0; lines < len(t.cellsTextMerged); lines++ {
+		height += t.cellsTextMerged[lines].MinHeight()
+		if height > firstHeight {
+			break
+		}
+	}
+	if len(t.cellsTextMerged)-lines < t.widows {
+		lines = len(t.cellsTextMerged) - t.widows
+	}
+	if lines < 1 || lines < t.orphans || lines >= len(t.cellsTextMerged) { //Too little space
+		return nil
+	}
+	next := *t
+	next.cellsText = append([]CellText{}, t.cellsTextBroken[t.lineStart[lines]:]...)
+	t.cellsText = t.cellsTextBroken[:t.lineStart[lines]]
+	t.continues = next.cellsText[0].newlines == 0 //The paragraph continues
+	next.cellsText[0].newlines = 0                //No space of the paragraph at the top of the page
+	width := t.rectangle.width
+	t.Build(pdf, width)
+	next.Build(pdf, width)
+	return &next
+}
+func (t *CellTextArea) clone() Component {
+	area := *t
+	area.cellsText = append([]CellText{}, t.cellsText...)
+	return &area
+}
+func (t *CellTextArea) SetPageContext(context PageContext) {
+	for i := range t.cellsText {
+		t.cellsText[i].SetPageContext(context)
+	}
+	for i := range t.cellsTextMerged {
+		t.cellsTextMerged[i].SetPageContext(context)
+	}
 }
 func (t CellTextArea) MinHeight() float64 {
 	tot := t.minMarginText.top + t.minMarginText.bottom
@@ -157,14 +434,14 @@ func (t CellTextArea) GetRectPosition() (x, y float64) {
 	return t.rectangle.lowerX, t.rectangle.lowerY
 }
 func (t CellTextArea) IsSplittable() bool {
-	return false
+	return true
 }
 
 func (t CellTextArea) getCellTextStartPosition(pdf *gopdf.GoPdf) (x float64, y float64) {
 	cellWidth := t.cellWidth(pdf)
 	cellHeight := t.cellHeight()
 	switch t.horizontalAlign {
-	case gopdf.Left:
+	case gopdf.Left, Justify:
 		x = t.rectangle.lowerX + t.minMarginText.left
 	case gopdf.Right:
 		x = t.rectangle.lowerX + t.rectangle.width - t.minMarginText.right - cellWidth
@@ -181,6 +458,35 @@ func (t CellTextArea) getCellTextStartPosition(pdf *gopdf.GoPdf) (x float64, y f
 	}
 	return x, y
 }
+
+// Spread the space left in width over the gaps between the words of every line but the last
+func (t *CellTextArea) justify(pdf *gopdf.GoPdf, width float64) {
+	for i := range t.cellsTextMerged {
+		line := &t.cellsTextMerged[i]
+		line.gapExtra = 0
+		last := i == len(t.cellsTextMerged)-1 && !t.continues || i < len(t.cellsTextMerged)-1 &&
+			t.cellsTextMerged[i+1].newlines > 0 //Last line of a paragraph
+		if gaps := line.gaps(); gaps > 0 && !last {
+			//A little less than the space left, so the rounding of the sum doesn't exceed width
+			if extra := width - line.MinWidth(pdf) - 1e-6; extra > 0 {
+				line.gapExtra = extra / float64(gaps)
+			}
+		}
+	}
+}
+
+// Return the space above the line i: the empty lines and the space between the paragraphs
+func (t CellTextArea) paragraphSpacing(i int) float64 {
+	newlines := t.cellsTextMerged[i].newlines
+	if newlines == 0 {
+		return 0
+	}
+	space := float64(newlines-1) * t.lineSpacing() * t.lineHeight
+	if i > 0 {
+		space += t.paragraphSpace
+	}
+	return space
+}
 func (t CellTextArea) cellHeight() float64 {
 	sum := 0.0
 	for _, v := range t.cellsTextMerged {
@@ -0,0 +1,90 @@
+package reportengine
+
+import (
+	"fmt"
+	"github.com/signintech/gopdf"
+)
+
+const (
+	ColumnAuto = iota
+	ColumnFixed
+	ColumnPercentage
+	ColumnWeight
+)
+
+type ColumnWidth struct {
+	widthType int
+	value     float64
+}
+
+func NewAutoColumn() ColumnWidth {
+	return ColumnWidth{widthType: ColumnAuto}
+}
+func NewFixedColumn(width float64) ColumnWidth {
+	return ColumnWidth{widthType: ColumnFixed, value: width}
+}
+func NewPercentageColumn(percentage float64) ColumnWidth {
+	return ColumnWidth{widthType: ColumnPercentage, value: percentage}
+}
+func NewWeightColumn(weight float64) ColumnWidth {
+	return ColumnWidth{widthType: ColumnWeight, value: weight}
+}
+
+// Return the width of every column inside maxWidth (cells[j] are the cells of column j).
+// Fixed and percentage columns take their own width, auto columns the greatest MinWidth of their cells.
+// The leftover space goes to weight columns (proportionally to the weight), or in equal parts to auto
+// columns if there are no weight columns, or to all columns otherwise.
+// Return an error if fixed, percentage and auto columns don't fit in maxWidth.
+func layoutColumns(pdf *gopdf.GoPdf, columns []ColumnWidth, cells [][]Component, maxWidth float64) ([]float64, error) {
+	widths := make([]float64, len(columns))
+	used, totalWeight := 0.0, 0.0
+	nAuto := 0
+	for j, c := range columns {
+		switch c.widthType {
+		case ColumnFixed:
+			widths[j] = c.value
+		case ColumnPercentage:
+			widths[j] = maxWidth * c.value / 100.0
+		case ColumnWeight:
+			totalWeight += c.value
+		case ColumnAuto:
+			nAuto++
+			if j < len(cells) {
+				for _, cell := range cells[j] {
+					//Built with all space, so MinWidth is the natural width of the cell
+					cell.Build(pdf, maxWidth)
+					if temp := cell.MinWidth(pdf); temp > widths[j] {
+						widths[j] = temp
+					}
+				}
+			}
+		}
+		if widths[j] < 0 {
+			return nil, fmt.Errorf("%w: column %d has a negative width (%.2f)", ErrColumnsOverflow, j, widths[j])
+		}
+		used += widths[j]
+	}
+	leftover := maxWidth - used
+	if leftover < 0 {
+		return nil, fmt.Errorf("%w: columns need %.2f points but only %.2f are available", ErrColumnsOverflow, used, maxWidth)
+	}
+	switch {
+	case totalWeight > 0:
+		for j, c := range columns {
+			if c.widthType == ColumnWeight {
+				widths[j] = leftover * c.value / totalWeight
+			}
+		}
+	case nAuto > 0:
+		for j, c := range columns {
+			if c.widthType == ColumnAuto {
+				widths[j] += leftover / float64(nAuto)
+			}
+		}
+	case used > 0:
+		for j := range widths {
+			widths[j] += leftover * widths[j] / used
+		}
+	}
+	return widths, nil
+}
@@ -17,3 +17,30 @@ type Component interface {
 	IsSplittable() bool
 	Split(pdf *gopdf.GoPdf, firstHeight float64, splitType int) Component
 }
+
+// pageContextSetter is implemented by the components with page fields (f{page}, f{pages}, ...) or that
+// contain other components
+type pageContextSetter interface {
+	SetPageContext(context PageContext)
+}
+
+// Set the context of the page where component is rendered, if it uses it
+func setPageContext(component Component, context PageContext) {
+	if c, ok := component.(pageContextSetter); ok {
+		c.SetPageContext(context)
+	}
+}
+
+// cloner is implemented by the splittable components. The report splits a copy of them, so the
+// original contents are not changed and can be laid out again by another Build.
+type cloner interface {
+	clone() Component
+}
+
+// Return a copy of component that can be split without changing it, or component if it can't be copied
+func cloneComponent(component Component) Component {
+	if c, ok := component.(cloner); ok {
+		return c.clone()
+	}
+	return component
+}
@@ -86,6 +86,23 @@ func getTable(nRow, nCol int) Component {
 		gopdf.Center, gopdf.Middle)
 }
 
+func getTableWithSpans(nRow int) Component {
+	m := make([][]Component, nRow+2)
+	m[0] = []Component{NewSpanCell(getCellTextAreaStr("#"), 2, 1), NewSpanCell(getCellTextAreaStr("Group"), 1, 2),
+		getCellTextAreaStr("Notes")}
+	m[1] = []Component{getCellTextAreaStr("A"), getCellTextAreaStr("B"), getCellTextAreaStr("C")}
+	for i := 2; i < nRow+2; i++ {
+		if i%3 == 2 {
+			m[i] = []Component{NewSpanCell(getCellTextAreaStr(strconv.Itoa(i-1)), 3, 1), getRandomCellTextArea(),
+				getRandomCellTextArea(), getCellTextArea()}
+		} else {
+			m[i] = []Component{NewSpanCell(getRandomCellTextArea(), 1, 2), getRandomCellTextArea()}
+		}
+	}
+	return NewGrid(m, NewRectangle(gopdf.AllBorders, Solid, 1.1, White(), Black(), true), NewMargin(5),
+		gopdf.Center, gopdf.Middle)
+}
+
 func getCellTextAreaStr(str string) Component {
 	return NewCellTextArea(gopdf.Center, gopdf.Middle, str,
 		true, "ArchitectsDaughter-Regular", 14, Color{0, 255, 255},
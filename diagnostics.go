@@ -0,0 +1,132 @@
+package reportengine
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// Kinds of Diagnostic
+const (
+	DiagnosticTruncated        = iota //Text shortened to fit the cell (ellipsis or clip)
+	DiagnosticTokenDropped            //Icon or field removed with the text around it
+	DiagnosticImageFallback           //Image not valid, replaced by ImgUnsupportedFormat
+	DiagnosticShrunk                  //Font reduced to fit the cell (OverflowShrink)
+	DiagnosticComponentDropped        //Content taller than an empty page, not laid out (Build fails with ErrNoProgress)
+)
+
+// Diagnostic records a value that is not printed as it is, collected by Report.Build (see Report.Diagnostics)
+type Diagnostic struct {
+	Kind   int
+	Page   int    //Page of the document, from 1
+	Path   string //Component in the page, like "contents[2]/Grid[3][1]/CellText" or "header/CellText"
+	Value  string //Original value
+	Shown  string //Value printed, empty for an image
+	Reason string
+}
+
+func (d Diagnostic) String() string {
+	kind := map[int]string{DiagnosticTruncated: "truncated", DiagnosticTokenDropped: "token dropped",
+		DiagnosticImageFallback: "image fallback", DiagnosticShrunk: "shrunk",
+		DiagnosticComponentDropped: "component dropped"}[d.Kind]
+	return fmt.Sprintf("page %d %s: %s %q -> %q (%s)", d.Page, d.Path, kind, d.Value, d.Shown, d.Reason)
+}
+
+// diagnoser is implemented by the components that can change their value while they are built.
+// The paths of the diagnostics are relative to the component: "" for itself, "[i][j]/CellText" for a child.
+type diagnoser interface {
+	diagnostics() []Diagnostic
+}
+
+// Return the diagnostics of component, with the paths starting from its name
+func componentDiagnostics(component Component) []Diagnostic {
+	d, ok := component.(diagnoser)
+	if !ok {
+		return nil
+	}
+	diagnostics := d.diagnostics()
+	name := componentName(component)
+	for i := range diagnostics {
+		diagnostics[i].Path = name + diagnostics[i].Path
+	}
+	return diagnostics
+}
+
+// Return the name of the type of component, like "Grid"
+func componentName(component Component) string {
+	return reflect.Indirect(reflect.ValueOf(component)).Type().Name()
+}
+
+// Return the diagnostics of child, with the paths starting from prefix
+func childDiagnostics(prefix string, child Component) []Diagnostic {
+	diagnostics := componentDiagnostics(child)
+	for i := range diagnostics {
+		diagnostics[i].Path = prefix + diagnostics[i].Path
+	}
+	return diagnostics
+}
+
+func (t *Grid) diagnostics() []Diagnostic {
+	diagnostics := make([]Diagnostic, 0)
+	for i := range t.matrix {
+		for j := range t.matrix[i] {
+			diagnostics = append(diagnostics, childDiagnostics(fmt.Sprintf("[%d][%d]/", i, j), t.matrix[i][j])...)
+		}
+	}
+	return diagnostics
+}
+func (t *SpanCell) diagnostics() []Diagnostic {
+	return childDiagnostics("/", t.Component)
+}
+func (t *CellTextArea) diagnostics() []Diagnostic {
+	diagnostics := make([]Diagnostic, 0)
+	for i := range t.cellsTextMerged {
+		diagnostics = append(diagnostics, childDiagnostics(fmt.Sprintf("[%d]/", i), &t.cellsTextMerged[i])...)
+	}
+	return diagnostics
+}
+func (t *CellText) diagnostics() []Diagnostic {
+	if t.wrapped != nil {
+		return t.wrapped.diagnostics()
+	}
+	return append([]Diagnostic{}, t.truncations...)
+}
+func (t *CellImage) diagnostics() []Diagnostic {
+	if t.fallback == nil {
+		return nil
+	}
+	return []Diagnostic{{Kind: DiagnosticImageFallback, Value: t.originalValue, Reason: t.fallback.Error()}}
+}
+
+// Record that the font of the text was reduced from the font size of the cell to size
+func (t *CellText) recordShrink(size int) {
+	shown := ""
+	for _, token := range t.tokens {
+		shown += token.value
+	}
+	t.truncations = append(t.truncations, Diagnostic{Kind: DiagnosticShrunk, Value: t.originalValue,
+		Shown: shown, Reason: fmt.Sprintf("font size %d to %d", t.fontSize, size)})
+}
+
+// Record that the tokens of the text were shortened to shown: a truncation and the icons and fields
+// dropped, the units of original not kept
+func (t *CellText) recordTruncation(original []Token, units []wordUnit, kept func(unit int) bool, reason string) {
+	shown := ""
+	for _, token := range t.tokens {
+		shown += token.value
+	}
+	t.truncations = append(t.truncations, Diagnostic{Kind: DiagnosticTruncated, Value: t.originalValue,
+		Shown: shown, Reason: reason})
+	for i, unit := range units {
+		token := original[unit.token]
+		if !kept(i) && (token.field != "" || token.fontFamily == IconFontFamily) {
+			value := token.value
+			if token.fieldLayout != "" {
+				value = "f{" + token.field + ";" + token.fieldLayout + "}"
+			} else if token.field != "" {
+				value = "f{" + token.field + "}"
+			}
+			t.truncations = append(t.truncations, Diagnostic{Kind: DiagnosticTokenDropped, Value: value,
+				Reason: reason})
+		}
+	}
+}
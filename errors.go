@@ -0,0 +1,117 @@
+package reportengine
+
+import (
+	"errors"
+	"fmt"
+	"github.com/signintech/gopdf"
+)
+
+var (
+	ErrInsufficientWidth = errors.New("width is not sufficient")
+	ErrInsufficientSpace = errors.New("width/height are not sufficient")
+	ErrColumnsOverflow   = errors.New("columns don't fit in the available width")
+	ErrFontNotFound      = errors.New("font not found")
+	ErrInvalidImage      = errors.New("image not valid")
+	ErrNoProgress        = errors.New("pagination makes no progress")
+	ErrTextOverflow      = errors.New("text doesn't fit in the cell")
+	ErrSourceConsumed    = errors.New("rows of the source already read")
+)
+
+// ComponentError is the error of an operation (Build, Adjust, Render, ...) of a component.
+// Err wraps one of the Err* values, so the reason can be checked with errors.Is.
+type ComponentError struct {
+	Component Component
+	Operation string
+	Err       error
+}
+
+func newComponentError(component Component, operation string, err error) *ComponentError {
+	return &ComponentError{Component: component, Operation: operation, Err: err}
+}
+
+func (e *ComponentError) Error() string {
+	if e.Component == nil {
+		return fmt.Sprintf("%s: %s", e.Operation, e.Err.Error())
+	}
+	return fmt.Sprintf("%s %T: %s", e.Operation, e.Component, e.Err.Error())
+}
+func (e *ComponentError) Unwrap() error {
+	return e.Err
+}
+
+// buildChecker is implemented by the components that can tell before Build if it fails
+type buildChecker interface {
+	checkBuild(pdf *gopdf.GoPdf, maxWidth float64) *ComponentError
+}
+
+// Return the error of Adjust if component needs more than width x height
+func checkSpace(component Component, pdf *gopdf.GoPdf, width, height float64) *ComponentError {
+	if component.MinWidth(pdf) > width || component.MinHeight() > height {
+		return newComponentError(component, "Adjust", fmt.Errorf("%w: %.2fx%.2f needed, %.2fx%.2f available",
+			ErrInsufficientSpace, component.MinWidth(pdf), component.MinHeight(), width, height))
+	}
+	return nil
+}
+
+// SafeBuild calls component.Build returning the error instead of panicking
+func SafeBuild(component Component, pdf *gopdf.GoPdf, maxWidth float64) (err error) {
+	if c, ok := component.(buildChecker); ok {
+		if err := c.checkBuild(pdf, maxWidth); err != nil {
+			return err
+		}
+	}
+	defer recoverComponentError(component, "Build", &err)
+	component.Build(pdf, maxWidth)
+	return nil
+}
+
+// SafeAdjust calls component.Adjust returning the error instead of panicking
+func SafeAdjust(component Component, pdf *gopdf.GoPdf, lowerX, lowerY, width, height float64) (err error) {
+	if err := checkSpace(component, pdf, width, height); err != nil {
+		return err
+	}
+	defer recoverComponentError(component, "Adjust", &err)
+	component.Adjust(pdf, lowerX, lowerY, width, height)
+	return nil
+}
+
+// SafeSplit calls component.Split returning the error instead of panicking
+func SafeSplit(component Component, pdf *gopdf.GoPdf, firstHeight float64, splitType int) (next Component, err error) {
+	defer recoverComponentError(component, "Split", &err)
+	return component.Split(pdf, firstHeight, splitType), nil
+}
+
+// SafeRender calls component.Render returning the error instead of panicking
+func SafeRender(component Component, pdf *gopdf.GoPdf) (err error) {
+	defer recoverComponentError(component, "Render", &err)
+	component.Render(pdf)
+	return nil
+}
+
+// Convert the panic raised by the library, a *ComponentError (of the components inside, of the fonts, ...),
+// in the error, the component is set if the panic didn't identify it.
+// Any other panic (a bug, like a nil pointer dereference) is raised again.
+func recoverComponentError(component Component, operation string, err *error) {
+	r := recover()
+	if r == nil {
+		return
+	}
+	v, ok := r.(error)
+	var ce *ComponentError
+	if !ok || !errors.As(v, &ce) {
+		panic(r)
+	}
+	if ce.Component == nil {
+		ce.Component = component
+	}
+	*err = v
+}
+
+// Return err, returned by a Safe* function, as the *ComponentError it is
+func asComponentError(component Component, operation string, err error) *ComponentError {
+	var ce *ComponentError
+	if errors.As(err, &ce) {
+		return ce
+	}
+	return newComponentError(component, operation, err)
+}
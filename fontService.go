@@ -1,37 +1,344 @@
 package reportengine
 
 import (
+	_ "embed"
+	"errors"
+	"fmt"
 	"github.com/signintech/gopdf"
+	"io/fs"
+	"math"
 	"os"
 	"path/filepath"
-	"regexp"
+	"runtime"
+	"sort"
 	"strings"
+	"sync"
 )
 
-var fontMap map[string]string
+// FontRegistry maps the font families used by the components to their TrueType fonts.
+// The family of a font registered from a directory is the name of its file without ".ttf".
+type FontRegistry struct {
+	mutex    sync.RWMutex
+	fonts    map[string]fontSource
+	families map[string][]fontVariant //Normalized family name -> its fonts, built when needed
+	infos    map[string]*fontInfo     //Font -> its data read from the TrueType file, read when needed
+	bundled  bool                     //The bundled fonts are registered the first time a font is not found
+	once     sync.Once
+}
 
-func init() {
-	fontMap = make(map[string]string)
-	libRegEx, err := regexp.Compile("\\.ttf$")
-	if err != nil {
-		panic(err.Error())
+// A font of a family, for example Roboto-BoldItalic is the font of Roboto with weight 700 and italic
+type fontVariant struct {
+	name   string
+	weight int
+	italic bool
+}
+
+// Weights of the style names of the font files (Family-StyleName.ttf)
+var fontWeights = map[string]int{
+	"Thin":       FontWeightThin,
+	"ExtraLight": FontWeightExtraLight,
+	"Light":      FontWeightLight,
+	"":           FontWeightRegular,
+	"Regular":    FontWeightRegular,
+	"Medium":     FontWeightMedium,
+	"SemiBold":   FontWeightSemiBold,
+	"Bold":       FontWeightBold,
+	"ExtraBold":  FontWeightExtraBold,
+	"Black":      FontWeightBlack,
+}
+
+// Where the data of a font is: a file, a file of a fs.FS or the data itself
+type fontSource struct {
+	path string
+	fsys fs.FS
+	data []byte
+}
+
+// Fonts is the registry used by LoadFont and Width. A font not registered is looked for in the bundled fonts.
+var Fonts = newDefaultFontRegistry()
+
+// bundledFonts is the fonts directory, embedded only with the build tag embedfonts (see fontsEmbed.go)
+var bundledFonts fs.FS
+
+// The font of IconFontFamily, used by the components for the icons and the empty cells
+//
+//go:embed fonts/icons/MaterialDesignIcons.ttf
+var iconFont []byte
+
+// NewFontRegistry returns a registry with only the font of IconFontFamily
+func NewFontRegistry() *FontRegistry {
+	registry := &FontRegistry{fonts: make(map[string]fontSource)}
+	registry.RegisterBytes(IconFontFamily, iconFont)
+	return registry
+}
+func newDefaultFontRegistry() *FontRegistry {
+	registry := NewFontRegistry()
+	registry.bundled = true
+	return registry
+}
+
+// RegisterFile registers the font file path as family
+func (t *FontRegistry) RegisterFile(family, path string) error {
+	if _, err := os.Stat(path); err != nil {
+		return fmt.Errorf("%w: %s: %v", ErrFontNotFound, family, err)
 	}
-	err = filepath.Walk(RootDirectoryFonts, func(path string, info os.FileInfo, err error) error {
-		if err == nil && libRegEx.MatchString(info.Name()) {
-			family := strings.Replace(info.Name(), ".ttf", "", -1)
-			fontMap[family] = path
+	t.register(family, fontSource{path: path})
+	return nil
+}
+
+// RegisterBytes registers the TrueType data as family
+func (t *FontRegistry) RegisterBytes(family string, data []byte) {
+	t.register(family, fontSource{data: data})
+}
+
+// RegisterFS registers every .ttf file of fsys (for example an embed.FS) under the directory root.
+// The files are read only when the font is used.
+func (t *FontRegistry) RegisterFS(fsys fs.FS, root string) error {
+	return t.registerFS(fsys, root, true)
+}
+
+// Register the .ttf files of fsys under root, replacing the fonts already registered if replace is true
+func (t *FontRegistry) registerFS(fsys fs.FS, root string, replace bool) error {
+	return fs.WalkDir(fsys, root, func(name string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		family := strings.TrimSuffix(entry.Name(), ".ttf")
+		if !entry.IsDir() && strings.HasSuffix(entry.Name(), ".ttf") && (replace || !t.has(family)) {
+			t.register(family, fontSource{path: name, fsys: fsys})
 		}
 		return nil
 	})
+}
+
+// RegisterDir registers every .ttf file under the directory dir
+func (t *FontRegistry) RegisterDir(dir string) error {
+	return t.RegisterFS(os.DirFS(dir), ".")
+}
+
+// RegisterBundled registers the fonts of the fonts directory of this package: the ones embedded in the
+// binary with the build tag embedfonts, otherwise the ones of the source of the package (in the module cache).
+func (t *FontRegistry) RegisterBundled() error {
+	fsys, err := getBundledFonts()
 	if err != nil {
-		panic(err.Error())
+		return err
+	}
+	return t.RegisterFS(fsys, "fonts")
+}
+
+// Return the file system with the fonts directory of this package
+func getBundledFonts() (fs.FS, error) {
+	if bundledFonts != nil {
+		return bundledFonts, nil
+	}
+	_, file, _, ok := runtime.Caller(0)
+	if !ok {
+		return nil, fmt.Errorf("%w: bundled fonts not embedded (build tag embedfonts)", ErrFontNotFound)
+	}
+	dir := filepath.Dir(file)
+	if _, err := os.Stat(filepath.Join(dir, "fonts")); err != nil {
+		return nil, fmt.Errorf("%w: bundled fonts not embedded (build tag embedfonts): %v", ErrFontNotFound, err)
+	}
+	return os.DirFS(dir), nil
+}
+
+// Register the bundled fonts, without replacing the registered ones, if the registry falls back to them
+// and family is not registered
+func (t *FontRegistry) fallback(family string) {
+	if !t.bundled || t.has(family) {
+		return
 	}
+	t.once.Do(func() {
+		if fsys, err := getBundledFonts(); err == nil {
+			_ = t.registerFS(fsys, "fonts", false)
+		}
+	})
+}
+
+// Families returns the registered families, sorted
+func (t *FontRegistry) Families() []string {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+	families := make([]string, 0, len(t.fonts))
+	for family := range t.fonts {
+		families = append(families, family)
+	}
+	sort.Strings(families)
+	return families
 }
+
+// Has returns true if family is registered
+func (t *FontRegistry) Has(family string) bool {
+	t.fallback(family)
+	return t.has(family)
+}
+func (t *FontRegistry) has(family string) bool {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+	_, ok := t.fonts[family]
+	return ok
+}
+func (t *FontRegistry) register(family string, source fontSource) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	t.fonts[family] = source
+	t.families = nil
+	delete(t.infos, family)
+}
+
+// Resolve returns the registered font of family with the weight (FontWeightRegular, FontWeightBold, ...)
+// and the style nearest to the requested ones. family is the name of the family (like "Roboto" or
+// "IBM Plex Sans") or of one of its fonts (like "Roboto-Regular"), that is returned as is if weight is 0
+// and italic is false. Without the exact weight the nearest one is used, the heavier on equal distance
+// from weights from FontWeightRegular up. Without italic fonts the upright ones are used.
+func (t *FontRegistry) Resolve(family string, weight int, italic bool) (string, error) {
+	if weight == 0 && !italic && t.Has(family) {
+		return family, nil
+	}
+	if weight == 0 {
+		weight = FontWeightRegular
+	}
+	t.fallback(family)
+	t.mutex.Lock()
+	if t.families == nil {
+		t.families = make(map[string][]fontVariant)
+		for name := range t.fonts {
+			variant := parseFontName(name)
+			key := normalizeFamily(strings.SplitN(name, "-", 2)[0])
+			t.families[key] = append(t.families[key], variant)
+		}
+	}
+	variants := t.families[normalizeFamily(strings.SplitN(family, "-", 2)[0])]
+	t.mutex.Unlock()
+	best := -1
+	for i, v := range variants {
+		if best < 0 || betterVariant(v, variants[best], weight, italic) {
+			best = i
+		}
+	}
+	if best < 0 {
+		return "", fmt.Errorf("%w: %s", ErrFontNotFound, family)
+	}
+	return variants[best].name, nil
+}
+
+// Return true if a matches the requested style better than b
+func betterVariant(a, b fontVariant, weight int, italic bool) bool {
+	if (a.italic == italic) != (b.italic == italic) {
+		return a.italic == italic
+	}
+	da, db := math.Abs(float64(a.weight-weight)), math.Abs(float64(b.weight-weight))
+	if da != db {
+		return da < db
+	}
+	if a.weight != b.weight {
+		return (a.weight > b.weight) == (weight >= FontWeightRegular)
+	}
+	return a.name < b.name //Same style, the choice doesn't depend on the map order
+}
+
+// Return the style of the font with the name Family-StyleName, a name without a known style is regular
+func parseFontName(name string) fontVariant {
+	variant := fontVariant{name: name, weight: FontWeightRegular}
+	parts := strings.SplitN(name, "-", 2)
+	if len(parts) < 2 {
+		return variant
+	}
+	style := parts[1]
+	if strings.HasSuffix(style, "Italic") {
+		style = strings.TrimSuffix(style, "Italic")
+		variant.italic = true
+	}
+	if weight, ok := fontWeights[style]; ok {
+		variant.weight = weight
+	} else {
+		variant.italic = false
+	}
+	return variant
+}
+func normalizeFamily(family string) string {
+	return strings.ToLower(strings.NewReplacer(" ", "", "_", "").Replace(family))
+}
+
+// HasGlyph returns true if the font family (a registered font, like "Roboto-Regular") has a glyph for r.
+// A font that can't be read is supposed to have every glyph.
+func (t *FontRegistry) HasGlyph(family string, r rune) bool {
+	info := t.info(family)
+	if info == nil {
+		return true
+	}
+	return info.chars[int(r)] != 0
+}
+
+// Return the data of the font of family read from its file, nil if it can't be read
+func (t *FontRegistry) info(family string) *fontInfo {
+	t.mutex.RLock()
+	info, ok := t.infos[family]
+	t.mutex.RUnlock()
+	if ok {
+		return info
+	}
+	if data, err := t.read(family); err == nil {
+		info, _ = parseFontInfo(data)
+	}
+	t.mutex.Lock()
+	if t.infos == nil {
+		t.infos = make(map[string]*fontInfo)
+	}
+	t.infos[family] = info
+	t.mutex.Unlock()
+	return info
+}
+
+// Return the TrueType data of the font of family
+func (t *FontRegistry) read(family string) ([]byte, error) {
+	t.fallback(family)
+	t.mutex.RLock()
+	source, ok := t.fonts[family]
+	t.mutex.RUnlock()
+	switch {
+	case !ok:
+		return nil, errors.New("not registered")
+	case source.data != nil:
+		return source.data, nil
+	case source.fsys != nil:
+		return fs.ReadFile(source.fsys, source.path)
+	}
+	return os.ReadFile(source.path)
+}
+
+// Add the font of family to the document
+func (t *FontRegistry) load(pdf *gopdf.GoPdf, family string) error {
+	data, err := t.read(family)
+	if err != nil {
+		return err
+	}
+	return pdf.AddTTFFontData(family, data)
+}
+
+// Return the font of family with the weight and the style, or family if it can't be resolved
+// (so measuring the text fails with ErrFontNotFound)
+func resolveFontFamily(family string, weight int, italic bool) string {
+	font, err := Fonts.Resolve(family, weight, italic)
+	if err != nil {
+		return family
+	}
+	return font
+}
+
 func LoadFont(pdf *gopdf.GoPdf, family string) {
-	err := pdf.AddTTFFont(family, fontMap[family])
+	if err := SafeLoadFont(pdf, family); err != nil {
+		panic(err)
+	}
+}
+
+// SafeLoadFont calls LoadFont returning the error (ErrFontNotFound) instead of panicking
+func SafeLoadFont(pdf *gopdf.GoPdf, family string) error {
+	err := Fonts.load(pdf, family)
 	if err != nil {
-		panic(err.Error())
+		return newComponentError(nil, "LoadFont", fmt.Errorf("%w: %s: %v", ErrFontNotFound, family, err))
 	}
+	return nil
 }
 func Width(pdf *gopdf.GoPdf, fontFamily string, fontSize int, text string) float64 {
 	err := pdf.SetFont(fontFamily, "", fontSize)
@@ -40,13 +347,13 @@ func Width(pdf *gopdf.GoPdf, fontFamily string, fontSize int, text string) float
 			LoadFont(pdf, fontFamily)
 			err = pdf.SetFont(fontFamily, "", fontSize)
 			if err != nil {
-				panic(err)
+				panic(newComponentError(nil, "Width", fmt.Errorf("%w: %s: %v", ErrFontNotFound, fontFamily, err)))
 			}
 		}
 	}
 	x, err := pdf.MeasureTextWidth(text)
 	if err != nil {
-		panic(err)
+		panic(newComponentError(nil, "Width", fmt.Errorf("%w: %s: %v", ErrFontNotFound, fontFamily, err)))
 	}
 	return x
 }
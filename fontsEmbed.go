@@ -0,0 +1,15 @@
+//go:build embedfonts
+// +build embedfonts
+
+package reportengine
+
+import (
+	"embed"
+)
+
+//go:embed fonts
+var embeddedFonts embed.FS
+
+func init() {
+	bundledFonts = embeddedFonts
+}
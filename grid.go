@@ -2,6 +2,7 @@ package reportengine
 
 import (
 	"github.com/signintech/gopdf"
+	"sort"
 )
 
 type Grid struct {
@@ -10,6 +11,24 @@ type Grid struct {
 	minMargin       Margin
 	horizontalAlign uint
 	verticalAlign   uint
+	columns         []ColumnWidth
+
+	cells       [][]cellLayout
+	widths      []float64 //width of the columns
+	rowHeights  []float64
+	rowsHeight  float64 //sum of rowHeights
+	breakable   []bool  //breakable[i] is true if the grid can be split before row i
+	matrixWidth float64
+}
+
+// Position of a cell inside its row, x is relative to the left side of the matrix
+type cellLayout struct {
+	col     int
+	rowSpan int
+	colSpan int
+	x       float64
+	width   float64
+	height  float64
 }
 
 func NewGrid(matrix [][]Component, rectangle Rectangle, minMargin Margin,
@@ -23,53 +42,53 @@ func NewGrid(matrix [][]Component, rectangle Rectangle, minMargin Margin,
 	return grid
 }
 
+// SetColumns defines the width of the columns, used by the rows that cover exactly len(columns) columns.
+// The other rows split the available width in equal parts.
+func (t *Grid) SetColumns(columns ...ColumnWidth) {
+	t.columns = columns
+}
+
 func (t *Grid) Build(pdf *gopdf.GoPdf, maxWidth float64) {
 	maxWidthMatrix := maxWidth - t.minMargin.left - t.minMargin.right
+	err := t.layoutCells(pdf, maxWidthMatrix)
+	if err != nil {
+		panic(newComponentError(t, "Build", err))
+	}
 	//Built cells
 	for i := range t.matrix {
-		colWidth := maxWidthMatrix / float64(len(t.matrix[i]))
 		for j := range t.matrix[i] {
-			t.matrix[i][j].Build(pdf, colWidth)
+			t.matrix[i][j].Build(pdf, t.cells[i][j].width)
 		}
 	}
+	t.layoutRows()
 	//Adjust Alignment Cells
-	lowerY := t.minMargin.top
-	lowerX := t.minMargin.left
-	sumHeight := 0.0
-	for i := 0; i < len(t.matrix); i++ {
-		colWidth := maxWidthMatrix / float64(len(t.matrix[i]))
-		rowHeight := t.minHeightRow(i)
+	rowY := t.minMargin.top
+	for i := range t.matrix {
 		for j := range t.matrix[i] {
-			t.matrix[i][j].Adjust(pdf, lowerX+float64(j)*colWidth, lowerY, colWidth, rowHeight)
+			c := t.cells[i][j]
+			t.matrix[i][j].Adjust(pdf, t.minMargin.left+c.x, rowY, c.width, c.height)
 		}
-		lowerY += rowHeight
-		sumHeight += rowHeight
+		rowY += t.rowHeights[i]
 	}
 	t.rectangle.lowerY = 0
 	t.rectangle.lowerX = 0
 	t.rectangle.width = maxWidth
-	t.rectangle.height = sumHeight + t.minMargin.top + t.minMargin.bottom
+	t.rectangle.height = t.matrixHeight() + t.minMargin.top + t.minMargin.bottom
 }
 func (t *Grid) Adjust(pdf *gopdf.GoPdf, lowerX, lowerY, width, height float64) {
-	if t.MinWidth(pdf) > width || t.MinHeight() > height {
-		panic("Width/Height are not sufficient")
+	if err := checkSpace(t, pdf, width, height); err != nil {
+		panic(err)
 	}
 	t.rectangle.lowerY = lowerY
 	t.rectangle.lowerX = lowerX
 	t.rectangle.width = width
 	t.rectangle.height = height
 	lowerX, lowerY = t.getMatrixStartPosition()
-	for i := 0; i < len(t.matrix); i++ {
-		tempX := lowerX
-		max := 0.0
-		for j := 0; j < len(t.matrix[i]); j++ {
-			t.matrix[i][j].MoveTo(tempX, lowerY)
-			tempX += t.matrix[i][j].GetRectWidth()
-			if t.matrix[i][j].GetRectHeight() > max {
-				max = t.matrix[i][j].GetRectHeight()
-			}
+	for i := range t.matrix {
+		for j := range t.matrix[i] {
+			t.matrix[i][j].MoveTo(lowerX+t.cells[i][j].x, lowerY)
 		}
-		lowerY += max
+		lowerY += t.rowHeights[i]
 	}
 }
 func (t *Grid) MoveTo(lowerX, lowerY float64) {
@@ -87,35 +106,39 @@ func (t *Grid) MoveTo(lowerX, lowerY float64) {
 func (t *Grid) SetVisibilityContainer(isVisible bool) {
 	t.rectangle.isVisible = isVisible
 }
-func (t *Grid) Split(pdf *gopdf.GoPdf, firstHeight float64, splitType int) Component {
-	var row int
-	marginHeight := t.minMargin.top + t.minMargin.bottom
-	minHeight := marginHeight + t.matrix[0][0].GetRectHeight()
-	if minHeight > firstHeight { //Too little space
-		return nil
-	}
-	height := marginHeight
-	for row = 0; row < len(t.matrix); row++ {
-		rowHeight := t.matrix[row][0].GetRectHeight()
-		if height+rowHeight > firstHeight {
-			break
+
+// Split the grid only between rows not crossed by a row span, so merged cells stay on the same page.
+// With SplitRepeatFirstRow the first group of rows (the first row and the rows it spans) is repeated.
+// The cells are not built again: both grids keep the layout of the original one.
+func (t *Grid) Split(_ *gopdf.GoPdf, firstHeight float64, splitType int) Component {
+	firstGroup := 0
+	if splitType == SplitRepeatFirstRow {
+		firstGroup = 1
+		for firstGroup < len(t.matrix) && !t.breakable[firstGroup] {
+			firstGroup++
 		}
-		height += rowHeight
 	}
-	x := t.matrix[0:row]
-	y := t.matrix[row:len(t.matrix)]
-	if splitType == SplitRepeatFirstRow {
-		y = append([][]Component{t.matrix[0]}, y...)
+	next := t.splitAt(t.lastFittingRow(firstHeight), firstGroup)
+	if next == nil {
+		return nil
 	}
-	t.matrix = x
-	rec := t.rectangle
-	t.Build(pdf, rec.width)
-	next := NewGrid(y, t.rectangle, t.minMargin, t.horizontalAlign, t.verticalAlign)
-	next.Build(pdf, t.rectangle.width)
 	return next
 }
+
+// The copy shares the rows, a split changes only the slices of the grid split
+func (t *Grid) clone() Component {
+	grid := *t
+	return &grid
+}
+func (t *Grid) SetPageContext(context PageContext) {
+	for i := range t.matrix {
+		for j := range t.matrix[i] {
+			setPageContext(t.matrix[i][j], context)
+		}
+	}
+}
 func (t Grid) MinWidth(*gopdf.GoPdf) float64 {
-	return t.matrixWidth() + t.minMargin.left + t.minMargin.right
+	return t.matrixWidth + t.minMargin.left + t.minMargin.right
 }
 func (t Grid) MinHeight() float64 {
 	return t.matrixHeight() + t.minMargin.top + t.minMargin.bottom
@@ -144,45 +167,193 @@ func (t Grid) IsSplittable() bool {
 	return true
 }
 
-func (t Grid) matrixWidth() float64 {
-	max := 0.0
-	for _, i := range t.matrix {
-		sum := 0.0
-		for _, j := range i {
-			sum += j.GetRectWidth()
+// Place every cell in the columns (like HTML tables: a cell takes the first column not covered by a row span
+// of the previous rows) and compute its horizontal position and width.
+func (t *Grid) layoutCells(pdf *gopdf.GoPdf, maxWidth float64) error {
+	t.cells = make([][]cellLayout, len(t.matrix))
+	covered := make([]map[int]bool, len(t.matrix))
+	for i := range covered {
+		covered[i] = make(map[int]bool)
+	}
+	nColumns := 0
+	for i := range t.matrix {
+		t.cells[i] = make([]cellLayout, len(t.matrix[i]))
+		col := 0
+		for j := range t.matrix[i] {
+			for covered[i][col] {
+				col++
+			}
+			rowSpan, colSpan := getSpan(t.matrix[i][j])
+			if i+rowSpan > len(t.matrix) {
+				rowSpan = len(t.matrix) - i
+			}
+			t.cells[i][j] = cellLayout{col: col, rowSpan: rowSpan, colSpan: colSpan}
+			for r := i; r < i+rowSpan; r++ {
+				for c := col; c < col+colSpan; c++ {
+					covered[r][c] = true
+				}
+			}
+			col += colSpan
+		}
+	}
+	for i := range covered {
+		if len(covered[i]) > nColumns {
+			nColumns = len(covered[i])
 		}
-		if sum > max {
-			max = sum
+	}
+	if len(t.columns) > 0 {
+		nColumns = len(t.columns)
+	}
+	widths, err := t.columnWidths(pdf, nColumns, covered, maxWidth)
+	if err != nil {
+		return err
+	}
+	t.widths = widths
+	for i := range t.cells {
+		for j := range t.cells[i] {
+			c := &t.cells[i][j]
+			if len(covered[i]) == nColumns {
+				for k := 0; k < c.col+c.colSpan && k < nColumns; k++ {
+					if k < c.col {
+						c.x += widths[k]
+					} else {
+						c.width += widths[k]
+					}
+				}
+			} else { //Row not aligned with the columns
+				unit := maxWidth / float64(len(covered[i]))
+				c.x = float64(c.col) * unit
+				c.width = float64(c.colSpan) * unit
+			}
 		}
 	}
-	return max
+	return nil
 }
-func (t Grid) matrixHeight() float64 {
-	sum := 0.0
-	for _, i := range t.matrix {
-		max := 0.0
-		for _, j := range i {
-			temp := j.GetRectHeight()
-			if temp > max {
-				max = temp
+func (t Grid) columnWidths(pdf *gopdf.GoPdf, nColumns int, covered []map[int]bool, maxWidth float64) ([]float64, error) {
+	if len(t.columns) == 0 {
+		widths := make([]float64, nColumns)
+		for j := range widths {
+			widths[j] = maxWidth / float64(nColumns)
+		}
+		return widths, nil
+	}
+	cells := make([][]Component, nColumns)
+	for i := range t.cells {
+		if len(covered[i]) != nColumns {
+			continue
+		}
+		for j, c := range t.cells[i] {
+			if c.colSpan == 1 {
+				cells[c.col] = append(cells[c.col], t.matrix[i][j])
 			}
 		}
-		sum += max
 	}
-	return sum
+	return layoutColumns(pdf, t.columns, cells, maxWidth)
 }
-func (t Grid) minHeightRow(rowIndex int) float64 {
-	max := 0.0
-	for _, v := range t.matrix[rowIndex] {
-		temp := v.MinHeight()
-		if temp > max {
-			max = temp
+
+// Compute the height of every row from the built cells: a cell spanning several rows that is taller
+// than its rows gives the missing space to the last of them. Compute also where the grid can be split.
+func (t *Grid) layoutRows() {
+	t.rowHeights = make([]float64, len(t.matrix))
+	for i := range t.cells {
+		for j, c := range t.cells[i] {
+			if temp := t.matrix[i][j].MinHeight(); c.rowSpan == 1 && temp > t.rowHeights[i] {
+				t.rowHeights[i] = temp
+			}
+		}
+	}
+	spanned := make([][2]int, 0)
+	for i := range t.cells {
+		for j, c := range t.cells[i] {
+			if c.rowSpan > 1 {
+				spanned = append(spanned, [2]int{i, j})
+			}
+		}
+	}
+	sort.SliceStable(spanned, func(a, b int) bool {
+		return t.cells[spanned[a][0]][spanned[a][1]].rowSpan < t.cells[spanned[b][0]][spanned[b][1]].rowSpan
+	})
+	for _, v := range spanned {
+		i, c := v[0], t.cells[v[0]][v[1]]
+		missing := t.matrix[i][v[1]].MinHeight() - t.sumRowHeights(i, i+c.rowSpan)
+		if missing > 0 {
+			t.rowHeights[i+c.rowSpan-1] += missing
+		}
+	}
+	t.rowsHeight = t.sumRowHeights(0, len(t.rowHeights))
+	t.breakable = make([]bool, len(t.matrix)+1)
+	for i := range t.breakable {
+		t.breakable[i] = true
+	}
+	t.matrixWidth = 0
+	for i := range t.cells {
+		for j := range t.cells[i] {
+			c := &t.cells[i][j]
+			c.height = t.sumRowHeights(i, i+c.rowSpan)
+			for r := i + 1; r < i+c.rowSpan; r++ {
+				t.breakable[r] = false
+			}
+			if c.x+c.width > t.matrixWidth {
+				t.matrixWidth = c.x + c.width
+			}
 		}
 	}
-	return max
+}
+
+// Return the number of rows that fit in height, keeping the rows crossed by a row span together
+func (t Grid) lastFittingRow(height float64) int {
+	row := 0
+	sum := t.minMargin.top + t.minMargin.bottom
+	for i := 0; i < len(t.rowHeights); i++ {
+		sum += t.rowHeights[i]
+		if sum > height {
+			break
+		}
+		if t.breakable[i+1] {
+			row = i + 1
+		}
+	}
+	return row
+}
+
+// Keep the rows [0, row) and return the grid of the other rows, preceded by the first firstGroup rows.
+// Return nil if no row fits or only the repeated rows fit (the next part would be the same grid).
+// The next part has its own rows and the first part reslices them, the rows of the grid are never written.
+func (t *Grid) splitAt(row, firstGroup int) *Grid {
+	if row == 0 || row >= len(t.matrix) || row <= firstGroup {
+		return nil
+	}
+	firstHeight := t.sumRowHeights(0, row)
+	next := NewGrid(append(append(make([][]Component, 0, firstGroup+len(t.matrix)-row), t.matrix[:firstGroup]...),
+		t.matrix[row:]...), t.rectangle, t.minMargin, t.horizontalAlign, t.verticalAlign)
+	next.columns = t.columns
+	next.widths = t.widths
+	next.matrixWidth = t.matrixWidth
+	next.cells = append(append([][]cellLayout{}, t.cells[:firstGroup]...), t.cells[row:]...)
+	next.rowHeights = append(append([]float64{}, t.rowHeights[:firstGroup]...), t.rowHeights[row:]...)
+	next.breakable = append(append([]bool{}, t.breakable[:firstGroup]...), t.breakable[row:]...)
+	next.rowsHeight = t.rowsHeight - firstHeight + t.sumRowHeights(0, firstGroup)
+	t.matrix = t.matrix[:row:row]
+	t.cells = t.cells[:row:row]
+	t.rowHeights = t.rowHeights[:row:row]
+	t.breakable = t.breakable[: row+1 : row+1]
+	t.rowsHeight = firstHeight
+	t.rectangle.height = t.MinHeight()
+	next.rectangle.height = next.MinHeight()
+	return next
+}
+func (t Grid) sumRowHeights(from, to int) float64 {
+	sum := 0.0
+	for i := from; i < to && i < len(t.rowHeights); i++ {
+		sum += t.rowHeights[i]
+	}
+	return sum
+}
+func (t Grid) matrixHeight() float64 {
+	return t.rowsHeight
 }
 func (t Grid) getMatrixStartPosition() (x float64, y float64) {
-	matrixWidth := t.matrixWidth()
+	matrixWidth := t.matrixWidth
 	matrixHeight := t.matrixHeight()
 	switch t.horizontalAlign {
 	case gopdf.Left:
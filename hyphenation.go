@@ -0,0 +1,157 @@
+package reportengine
+
+import (
+	"embed"
+	"fmt"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+//go:embed hyphenation
+var hyphenationFiles embed.FS
+
+// Hyphenator finds where the words of a language can be hyphenated, with the Liang algorithm (TeX patterns)
+type hyphenator struct {
+	patterns   map[string][]int //Letters of the pattern -> values between the letters
+	exceptions map[string][]int //Word -> positions of the hyphens
+	leftMin    int
+	rightMin   int
+	maxLen     int
+}
+
+// Files and minimum number of letters before and after a hyphen of the languages
+var hyphenationLanguages = map[string]struct {
+	patterns   string
+	exceptions string
+	leftMin    int
+	rightMin   int
+}{
+	HyphenationItalian: {patterns: "hyphenation/it.pat", leftMin: 2, rightMin: 2},
+	HyphenationEnglish: {patterns: "hyphenation/en-us.pat", exceptions: "hyphenation/en-us.hyp", leftMin: 2, rightMin: 3},
+}
+
+var (
+	hyphenators      = make(map[string]*hyphenator)
+	hyphenatorsMutex sync.Mutex
+)
+
+// Return the hyphenator of language, the patterns are loaded the first time
+func getHyphenator(language string) (*hyphenator, error) {
+	hyphenatorsMutex.Lock()
+	defer hyphenatorsMutex.Unlock()
+	if h, ok := hyphenators[language]; ok {
+		return h, nil
+	}
+	files, ok := hyphenationLanguages[language]
+	if !ok {
+		return nil, fmt.Errorf("hyphenation language %q not available", language)
+	}
+	h := &hyphenator{patterns: make(map[string][]int), exceptions: make(map[string][]int),
+		leftMin: files.leftMin, rightMin: files.rightMin}
+	patterns, err := readHyphenationFile(files.patterns)
+	if err != nil {
+		return nil, err
+	}
+	for _, pattern := range patterns {
+		h.addPattern(pattern)
+	}
+	if files.exceptions != "" {
+		exceptions, err := readHyphenationFile(files.exceptions)
+		if err != nil {
+			return nil, err
+		}
+		for _, exception := range exceptions {
+			h.addException(exception)
+		}
+	}
+	hyphenators[language] = h
+	return h, nil
+}
+
+// Return the words of the file, without the comment lines (starting with %)
+func readHyphenationFile(name string) ([]string, error) {
+	data, err := hyphenationFiles.ReadFile(name)
+	if err != nil {
+		return nil, err
+	}
+	words := make([]string, 0)
+	for _, line := range strings.Split(string(data), "\n") {
+		if !strings.HasPrefix(line, "%") {
+			words = append(words, strings.Fields(line)...)
+		}
+	}
+	return words, nil
+}
+
+// Add a pattern like "a1b2c": the digits are the values between the letters
+func (t *hyphenator) addPattern(pattern string) {
+	letters := make([]rune, 0, len(pattern))
+	values := make([]int, 1, len(pattern)+1)
+	for _, r := range pattern {
+		if r >= '0' && r <= '9' {
+			values[len(values)-1] = int(r - '0')
+		} else {
+			letters = append(letters, r)
+			values = append(values, 0)
+		}
+	}
+	t.patterns[string(letters)] = values
+	if len(letters) > t.maxLen {
+		t.maxLen = len(letters)
+	}
+}
+
+// Add an exception like "ac-a-dem-ic"
+func (t *hyphenator) addException(exception string) {
+	positions := make([]int, 0)
+	n := 0
+	for _, r := range exception {
+		if r == '-' {
+			positions = append(positions, n)
+		} else {
+			n++
+		}
+	}
+	t.exceptions[strings.ReplaceAll(exception, "-", "")] = positions
+}
+
+// Return the positions (in runes) where word can be hyphenated, word must contain only the letters of the word
+func (t *hyphenator) hyphenate(word string) []int {
+	letters := []rune(word)
+	for i := range letters {
+		letters[i] = unicode.ToLower(letters[i])
+	}
+	positions := make([]int, 0)
+	if len(letters) < t.leftMin+t.rightMin {
+		return positions
+	}
+	if exception, ok := t.exceptions[string(letters)]; ok {
+		for _, p := range exception {
+			if p >= t.leftMin && p <= len(letters)-t.rightMin {
+				positions = append(positions, p)
+			}
+		}
+		return positions
+	}
+	//Word between dots, values[i] is the value before the letter i of the dotted word
+	dotted := append(append([]rune{'.'}, letters...), '.')
+	values := make([]int, len(dotted)+1)
+	for i := range dotted {
+		for j := i + 1; j <= len(dotted) && j-i <= t.maxLen; j++ {
+			if pattern, ok := t.patterns[string(dotted[i:j])]; ok {
+				for k, v := range pattern {
+					if v > values[i+k] {
+						values[i+k] = v
+					}
+				}
+			}
+		}
+	}
+	for p := t.leftMin; p <= len(letters)-t.rightMin; p++ {
+		if values[p+1]%2 == 1 { //Odd values allow the hyphen before the letter p of the word
+			positions = append(positions, p)
+		}
+	}
+	return positions
+}
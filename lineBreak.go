@@ -0,0 +1,227 @@
+package reportengine
+
+import (
+	"golang.org/x/text/width"
+	"sort"
+	"unicode"
+)
+
+// Line breaking classes of the Unicode line breaking algorithm (UAX #14) used to split the words,
+// lineBreakRanges (generated from LineBreak.txt by lineBreakGen.go) has the class of every code point.
+// The spaces and the newlines, that always separate the words, are handled by splitWords.
+const (
+	breakAL  = iota //Alphabetic, the default
+	breakAI         //Ambiguous (alphabetic or ideographic)
+	breakB2         //Break before and after (em dash)
+	breakBA         //Break after (en dash, soft hyphen)
+	breakBB         //Break before
+	breakBK         //Mandatory break
+	breakCB         //Contingent break (objects)
+	breakCJ         //Conditional Japanese starter (small kana)
+	breakCL         //Close punctuation
+	breakCM         //Combining mark
+	breakCP         //Close parenthesis
+	breakCR         //Carriage return
+	breakEB         //Emoji base
+	breakEM         //Emoji modifier
+	breakEX         //Exclamation and interrogation
+	breakGL         //Non-breaking (no-break space)
+	breakH2         //Hangul LV syllable
+	breakH3         //Hangul LVT syllable
+	breakHL         //Hebrew letter
+	breakHY         //Hyphen
+	breakID         //Ideographic (CJK, kana)
+	breakIN         //Inseparable (ellipsis)
+	breakIS         //Infix separator (, . : ;)
+	breakJL         //Hangul leading jamo
+	breakJT         //Hangul trailing jamo
+	breakJV         //Hangul vowel jamo
+	breakLF         //Line feed
+	breakNL         //Next line
+	breakNS         //Non-starter (iteration marks)
+	breakNU         //Numeric
+	breakOP         //Open punctuation
+	breakPO         //Postfix numeric (%)
+	breakPR         //Prefix numeric (currencies)
+	breakQU         //Quotation
+	breakRI         //Regional indicator (flags)
+	breakSA         //South East Asian (Thai, Lao, Khmer, Myanmar)
+	breakSG         //Surrogate
+	breakSP         //Space
+	breakSY         //Symbol allowing break after (/)
+	breakWJ         //Word joiner
+	breakXX         //Unknown
+	breakZW         //Zero width space
+	breakZWJ        //Zero width joiner
+)
+
+//go:generate go run lineBreakGen.go
+
+// Code points from lo to hi of a line breaking class
+type lineBreakRange struct {
+	lo, hi rune
+	class  uint8
+}
+
+// Return the line breaking class of r, resolved with LB1: the ambiguous, surrogate and unknown characters
+// are alphabetic, the conditional Japanese starters are non-starters
+func lineBreakClass(r rune) int {
+	i := sort.Search(len(lineBreakRanges), func(i int) bool { return lineBreakRanges[i].hi >= r })
+	if i == len(lineBreakRanges) || lineBreakRanges[i].lo > r {
+		return breakAL
+	}
+	switch class := int(lineBreakRanges[i].class); class {
+	case breakAI, breakSG, breakXX:
+		return breakAL
+	case breakCJ:
+		return breakNS
+	default:
+		return class
+	}
+}
+
+// Split the tokens of a word (text without spaces) where a line can be broken, following UAX #14:
+// between the ideographs, after the hyphens and the slashes, ... The icons and the fields are alphabetic.
+// The Thai and the other South East Asian scripts need a dictionary, they are broken only before
+// the leading vowels and after SARA A, SARA AM and MAI YAMOK.
+func lineBreakSegments(tokens []Token) [][]Token {
+	units := wordUnits(tokens)
+	breaks := make([]int, 0) //Units before which the line can be broken
+	var last lineBreakContext
+	joiner := false //The unit before is a zero width joiner (LB8a)
+	for i, unit := range units {
+		class, r := breakAL, rune(0)
+		if token := tokens[unit.token]; token.field == "" && token.fontFamily != IconFontFamily {
+			for _, r = range token.value[unit.start:] {
+				break
+			}
+			class = lineBreakClass(r)
+		}
+		if i > 0 && (class == breakCM || class == breakZWJ) && !last.in(breakBK, breakCR, breakLF, breakNL,
+			breakSP, breakZW) { //LB9: the marks and the joiners take the class of the character before them
+			joiner = class == breakZWJ
+			continue
+		}
+		next := lineBreakContext{before: last.class, class: class, r: r}
+		if class == breakCM || class == breakZWJ { //LB10: alone they are alphabetic
+			next.class = breakAL
+		}
+		if next.class == breakRI && last.class == breakRI {
+			next.regional = last.regional + 1
+		} else if next.class == breakRI {
+			next.regional = 1
+		}
+		if i > 0 && !joiner && canBreakLine(last, next) {
+			breaks = append(breaks, i)
+		}
+		last, joiner = next, class == breakZWJ
+	}
+	segments := make([][]Token, 0, len(breaks)+1)
+	for i := len(breaks) - 1; i >= 0; i-- {
+		first, rest := splitTokens(tokens, units[breaks[i]])
+		segments = append(segments, rest)
+		tokens = first
+	}
+	segments = append(segments, tokens)
+	for i, j := 0, len(segments)-1; i < j; i, j = i+1, j-1 {
+		segments[i], segments[j] = segments[j], segments[i]
+	}
+	return segments
+}
+
+// A character of a word for the line breaking rules
+type lineBreakContext struct {
+	before   int  //Class of the character before
+	class    int  //Class of the character, the marks after it included (LB9)
+	r        rune //The character
+	regional int  //Regional indicators up to the character, if it is one (LB30a)
+}
+
+func (t lineBreakContext) in(classes ...int) bool {
+	for _, class := range classes {
+		if t.class == class {
+			return true
+		}
+	}
+	return false
+}
+
+// Return true if the open or close punctuation r is wide, as the East Asian punctuation (LB30)
+func isWidePunctuation(r rune) bool {
+	kind := width.LookupRune(r).Kind()
+	return kind == width.EastAsianFullwidth || kind == width.EastAsianWide || kind == width.EastAsianHalfwidth
+}
+
+// Return true if the line can be broken between the characters a and b (rules LB8-LB31, the spaces are
+// between the words). The Thai and the other South East Asian scripts need a dictionary, they are broken
+// only before the leading vowels and after SARA A, SARA AM and MAI YAMOK.
+func canBreakLine(a, b lineBreakContext) bool {
+	if a.class == breakSA && b.class == breakSA {
+		return (b.r >= 0x0E40 && b.r <= 0x0E44) || (b.r >= 0x0EC0 && b.r <= 0x0EC4) ||
+			a.r == 0x0E30 || a.r == 0x0E33 || a.r == 0x0E46
+	}
+	//LB1: the other South East Asian characters are alphabetic
+	if a.class == breakSA {
+		a.class = breakAL
+	}
+	if b.class == breakSA {
+		b.class = breakAL
+	}
+	hangul := []int{breakJL, breakJV, breakJT, breakH2, breakH3}
+	switch {
+	case a.class == breakZW: //LB8
+		return true
+	case a.in(breakWJ) || b.in(breakWJ), a.in(breakGL): //LB11, LB12
+		return false
+	case b.in(breakGL): //LB12a
+		return a.in(breakBA, breakHY)
+	case b.in(breakCL, breakCP, breakEX, breakIS, breakSY): //LB13
+		return false
+	case a.in(breakOP): //LB14
+		return false
+	case a.in(breakQU) && b.in(breakOP): //LB15
+		return false
+	case a.in(breakCL, breakCP) && b.in(breakNS), a.in(breakB2) && b.in(breakB2): //LB16, LB17
+		return false
+	case a.in(breakQU) || b.in(breakQU): //LB19
+		return false
+	case a.in(breakCB) || b.in(breakCB): //LB20
+		return true
+	case b.in(breakBA, breakHY, breakNS), a.in(breakBB): //LB21
+		return false
+	case a.in(breakHY, breakBA) && a.before == breakHL, a.in(breakSY) && b.in(breakHL): //LB21a, LB21b
+		return false
+	case b.in(breakIN): //LB22
+		return false
+	case a.in(breakAL, breakHL) && b.in(breakNU), a.in(breakNU) && b.in(breakAL, breakHL): //LB23
+		return false
+	case a.in(breakPR) && b.in(breakID, breakEB, breakEM), a.in(breakID, breakEB, breakEM) && b.in(breakPO): //LB23a
+		return false
+	case a.in(breakPR, breakPO) && b.in(breakAL, breakHL), a.in(breakAL, breakHL) && b.in(breakPR, breakPO): //LB24
+		return false
+	case a.in(breakCL, breakCP, breakNU) && b.in(breakPO, breakPR), a.in(breakPO, breakPR) && b.in(breakOP, breakNU),
+		a.in(breakHY, breakIS, breakSY, breakNU) && b.in(breakNU): //LB25
+		return false
+	case a.in(breakJL) && b.in(breakJL, breakJV, breakH2, breakH3), a.in(breakJV, breakH2) && b.in(breakJV, breakJT),
+		a.in(breakJT, breakH3) && b.in(breakJT): //LB26
+		return false
+	case a.in(hangul...) && b.in(breakPO), a.in(breakPR) && b.in(hangul...): //LB27
+		return false
+	case a.in(breakAL, breakHL) && b.in(breakAL, breakHL), a.in(breakIS) && b.in(breakAL, breakHL): //LB28, LB29
+		return false
+	case a.in(breakAL, breakHL, breakNU) && b.in(breakOP) && !isWidePunctuation(b.r),
+		a.in(breakCP) && !isWidePunctuation(a.r) && b.in(breakAL, breakHL, breakNU): //LB30
+		return false
+	case a.in(breakRI) && b.in(breakRI): //LB30a: the flags are pairs of regional indicators
+		return a.regional%2 == 0
+	case a.in(breakEB) && b.in(breakEM), a.in(breakID) && b.in(breakEM) && isUnassignedPictographic(a.r): //LB30b
+		return false
+	}
+	return true //LB31
+}
+
+// Return true if r is a code point not assigned yet of the pictographic blocks, that can be an emoji base (LB30b)
+func isUnassignedPictographic(r rune) bool {
+	return r >= 0x1F000 && r <= 0x1FFFD &&
+		!unicode.In(r, unicode.L, unicode.M, unicode.N, unicode.P, unicode.S, unicode.Z, unicode.C)
+}
@@ -0,0 +1,113 @@
+//go:build ignore
+
+// Generate lineBreakTables.go from LineBreak.txt of the Unicode Character Database:
+//
+//	go run lineBreakGen.go [LineBreak.txt]
+//
+// Without the file it is downloaded from unicode.org.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"go/format"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+)
+
+const lineBreakURL = "https://www.unicode.org/Public/15.0.0/ucd/LineBreak.txt"
+
+// Classes of LineBreak.txt, a constant break<class> of lineBreak.go for each
+var classes = []string{"AL", "AI", "B2", "BA", "BB", "BK", "CB", "CJ", "CL", "CM", "CP", "CR", "EB", "EM", "EX",
+	"GL", "H2", "H3", "HL", "HY", "ID", "IN", "IS", "JL", "JT", "JV", "LF", "NL", "NS", "NU", "OP", "PO", "PR", "QU",
+	"RI", "SA", "SG", "SP", "SY", "WJ", "XX", "ZW", "ZWJ"}
+
+type codeRange struct {
+	lo, hi uint32
+	class  string
+}
+
+func main() {
+	var data []byte
+	var err error
+	if len(os.Args) > 1 {
+		data, err = os.ReadFile(os.Args[1])
+	} else {
+		var response *http.Response
+		if response, err = http.Get(lineBreakURL); err == nil {
+			data, err = io.ReadAll(response.Body)
+			response.Body.Close()
+		}
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+	known := make(map[string]bool)
+	for _, class := range classes {
+		known[class] = true
+	}
+	version := ""
+	ranges := make([]codeRange, 0)
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		line := scanner.Text()
+		if version == "" && strings.HasPrefix(line, "# LineBreak-") {
+			version = strings.TrimSuffix(strings.TrimPrefix(line, "# LineBreak-"), ".txt")
+		}
+		if i := strings.IndexByte(line, '#'); i >= 0 {
+			line = line[:i]
+		}
+		fields := strings.Split(line, ";")
+		if len(fields) != 2 {
+			continue
+		}
+		codes, class := strings.Split(strings.TrimSpace(fields[0]), ".."), strings.TrimSpace(fields[1])
+		if !known[class] {
+			log.Fatalf("unknown class %s", class)
+		}
+		lo, err := strconv.ParseUint(codes[0], 16, 32)
+		if err != nil {
+			log.Fatal(err)
+		}
+		hi := lo
+		if len(codes) == 2 {
+			if hi, err = strconv.ParseUint(codes[1], 16, 32); err != nil {
+				log.Fatal(err)
+			}
+		}
+		last := len(ranges) - 1
+		if last >= 0 && ranges[last].class == class && uint64(ranges[last].hi)+1 == lo {
+			ranges[last].hi = uint32(hi)
+			continue
+		}
+		if last >= 0 && uint64(ranges[last].hi) >= lo {
+			log.Fatalf("code points not sorted at %04X", lo)
+		}
+		ranges = append(ranges, codeRange{uint32(lo), uint32(hi), class})
+	}
+	if err := scanner.Err(); err != nil {
+		log.Fatal(err)
+	}
+
+	var out bytes.Buffer
+	fmt.Fprintf(&out, "// Code generated by lineBreakGen.go from LineBreak-%s.txt. DO NOT EDIT.\n\n", version)
+	fmt.Fprintf(&out, "package reportengine\n\n")
+	fmt.Fprintf(&out, "// Line breaking classes of the code points, sorted. The code points missing are XX.\n")
+	fmt.Fprintf(&out, "var lineBreakRanges = []lineBreakRange{\n")
+	for _, r := range ranges {
+		fmt.Fprintf(&out, "\t{0x%04X, 0x%04X, break%s},\n", r.lo, r.hi, r.class)
+	}
+	fmt.Fprintf(&out, "}\n")
+	source, err := format.Source(out.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile("lineBreakTables.go", source, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,2836 @@
+// Code generated by lineBreakGen.go from LineBreak-15.0.0.txt. DO NOT EDIT.
+
+package reportengine
+
+// Line breaking classes of the code points, sorted. The code points missing are XX.
+var lineBreakRanges = []lineBreakRange{
+	{0x0000, 0x0008, breakCM},
+	{0x0009, 0x0009, breakBA},
+	{0x000A, 0x000A, breakLF},
+	{0x000B, 0x000C, breakBK},
+	{0x000D, 0x000D, breakCR},
+	{0x000E, 0x001F, breakCM},
+	{0x0020, 0x0020, breakSP},
+	{0x0021, 0x0021, breakEX},
+	{0x0022, 0x0022, breakQU},
+	{0x0023, 0x0023, breakAL},
+	{0x0024, 0x0024, breakPR},
+	{0x0025, 0x0025, breakPO},
+	{0x0026, 0x0026, breakAL},
+	{0x0027, 0x0027, breakQU},
+	{0x0028, 0x0028, breakOP},
+	{0x0029, 0x0029, breakCP},
+	{0x002A, 0x002A, breakAL},
+	{0x002B, 0x002B, breakPR},
+	{0x002C, 0x002C, breakIS},
+	{0x002D, 0x002D, breakHY},
+	{0x002E, 0x002E, breakIS},
+	{0x002F, 0x002F, breakSY},
+	{0x0030, 0x0039, breakNU},
+	{0x003A, 0x003B, breakIS},
+	{0x003C, 0x003E, breakAL},
+	{0x003F, 0x003F, breakEX},
+	{0x0040, 0x005A, breakAL},
+	{0x005B, 0x005B, breakOP},
+	{0x005C, 0x005C, breakPR},
+	{0x005D, 0x005D, breakCP},
+	{0x005E, 0x007A, breakAL},
+	{0x007B, 0x007B, breakOP},
+	{0x007C, 0x007C, breakBA},
+	{0x007D, 0x007D, breakCL},
+	{0x007E, 0x007E, breakAL},
+	{0x007F, 0x0084, breakCM},
+	{0x0085, 0x0085, breakNL},
+	{0x0086, 0x009F, breakCM},
+	{0x00A0, 0x00A0, breakGL},
+	{0x00A1, 0x00A1, breakOP},
+	{0x00A2, 0x00A2, breakPO},
+	{0x00A3, 0x00A5, breakPR},
+	{0x00A6, 0x00A6, breakAL},
+	{0x00A7, 0x00A8, breakAI},
+	{0x00A9, 0x00A9, breakAL},
+	{0x00AA, 0x00AA, breakAI},
+	{0x00AB, 0x00AB, breakQU},
+	{0x00AC, 0x00AC, breakAL},
+	{0x00AD, 0x00AD, breakBA},
+	{0x00AE, 0x00AF, breakAL},
+	{0x00B0, 0x00B0, breakPO},
+	{0x00B1, 0x00B1, breakPR},
+	{0x00B2, 0x00B3, breakAI},
+	{0x00B4, 0x00B4, breakBB},
+	{0x00B5, 0x00B5, breakAL},
+	{0x00B6, 0x00BA, breakAI},
+	{0x00BB, 0x00BB, breakQU},
+	{0x00BC, 0x00BE, breakAI},
+	{0x00BF, 0x00BF, breakOP},
+	{0x00C0, 0x00D6, breakAL},
+	{0x00D7, 0x00D7, breakAI},
+	{0x00D8, 0x00F6, breakAL},
+	{0x00F7, 0x00F7, breakAI},
+	{0x00F8, 0x02C6, breakAL},
+	{0x02C7, 0x02C7, breakAI},
+	{0x02C8, 0x02C8, breakBB},
+	{0x02C9, 0x02CB, breakAI},
+	{0x02CC, 0x02CC, breakBB},
+	{0x02CD, 0x02CD, breakAI},
+	{0x02CE, 0x02CF, breakAL},
+	{0x02D0, 0x02D0, breakAI},
+	{0x02D1, 0x02D7, breakAL},
+	{0x02D8, 0x02DB, breakAI},
+	{0x02DC, 0x02DC, breakAL},
+	{0x02DD, 0x02DD, breakAI},
+	{0x02DE, 0x02DE, breakAL},
+	{0x02DF, 0x02DF, breakBB},
+	{0x02E0, 0x02FF, breakAL},
+	{0x0300, 0x034E, breakCM},
+	{0x034F, 0x034F, breakGL},
+	{0x0350, 0x035B, breakCM},
+	{0x035C, 0x0362, breakGL},
+	{0x0363, 0x036F, breakCM},
+	{0x0370, 0x0377, breakAL},
+	{0x037A, 0x037D, breakAL},
+	{0x037E, 0x037E, breakIS},
+	{0x037F, 0x037F, breakAL},
+	{0x0384, 0x038A, breakAL},
+	{0x038C, 0x038C, breakAL},
+	{0x038E, 0x03A1, breakAL},
+	{0x03A3, 0x0482, breakAL},
+	{0x0483, 0x0489, breakCM},
+	{0x048A, 0x052F, breakAL},
+	{0x0531, 0x0556, breakAL},
+	{0x0559, 0x0588, breakAL},
+	{0x0589, 0x0589, breakIS},
+	{0x058A, 0x058A, breakBA},
+	{0x058D, 0x058E, breakAL},
+	{0x058F, 0x058F, breakPR},
+	{0x0591, 0x05BD, breakCM},
+	{0x05BE, 0x05BE, breakBA},
+	{0x05BF, 0x05BF, breakCM},
+	{0x05C0, 0x05C0, breakAL},
+	{0x05C1, 0x05C2, breakCM},
+	{0x05C3, 0x05C3, breakAL},
+	{0x05C4, 0x05C5, breakCM},
+	{0x05C6, 0x05C6, breakEX},
+	{0x05C7, 0x05C7, breakCM},
+	{0x05D0, 0x05EA, breakHL},
+	{0x05EF, 0x05F2, breakHL},
+	{0x05F3, 0x05F4, breakAL},
+	{0x0600, 0x0608, breakAL},
+	{0x0609, 0x060B, breakPO},
+	{0x060C, 0x060D, breakIS},
+	{0x060E, 0x060F, breakAL},
+	{0x0610, 0x061A, breakCM},
+	{0x061B, 0x061B, breakEX},
+	{0x061C, 0x061C, breakCM},
+	{0x061D, 0x061F, breakEX},
+	{0x0620, 0x064A, breakAL},
+	{0x064B, 0x065F, breakCM},
+	{0x0660, 0x0669, breakNU},
+	{0x066A, 0x066A, breakPO},
+	{0x066B, 0x066C, breakNU},
+	{0x066D, 0x066F, breakAL},
+	{0x0670, 0x0670, breakCM},
+	{0x0671, 0x06D3, breakAL},
+	{0x06D4, 0x06D4, breakEX},
+	{0x06D5, 0x06D5, breakAL},
+	{0x06D6, 0x06DC, breakCM},
+	{0x06DD, 0x06DE, breakAL},
+	{0x06DF, 0x06E4, breakCM},
+	{0x06E5, 0x06E6, breakAL},
+	{0x06E7, 0x06E8, breakCM},
+	{0x06E9, 0x06E9, breakAL},
+	{0x06EA, 0x06ED, breakCM},
+	{0x06EE, 0x06EF, breakAL},
+	{0x06F0, 0x06F9, breakNU},
+	{0x06FA, 0x070D, breakAL},
+	{0x070F, 0x0710, breakAL},
+	{0x0711, 0x0711, breakCM},
+	{0x0712, 0x072F, breakAL},
+	{0x0730, 0x074A, breakCM},
+	{0x074D, 0x07A5, breakAL},
+	{0x07A6, 0x07B0, breakCM},
+	{0x07B1, 0x07B1, breakAL},
+	{0x07C0, 0x07C9, breakNU},
+	{0x07CA, 0x07EA, breakAL},
+	{0x07EB, 0x07F3, breakCM},
+	{0x07F4, 0x07F7, breakAL},
+	{0x07F8, 0x07F8, breakIS},
+	{0x07F9, 0x07F9, breakEX},
+	{0x07FA, 0x07FA, breakAL},
+	{0x07FD, 0x07FD, breakCM},
+	{0x07FE, 0x07FF, breakPR},
+	{0x0800, 0x0815, breakAL},
+	{0x0816, 0x0819, breakCM},
+	{0x081A, 0x081A, breakAL},
+	{0x081B, 0x0823, breakCM},
+	{0x0824, 0x0824, breakAL},
+	{0x0825, 0x0827, breakCM},
+	{0x0828, 0x0828, breakAL},
+	{0x0829, 0x082D, breakCM},
+	{0x0830, 0x083E, breakAL},
+	{0x0840, 0x0858, breakAL},
+	{0x0859, 0x085B, breakCM},
+	{0x085E, 0x085E, breakAL},
+	{0x0860, 0x086A, breakAL},
+	{0x0870, 0x088E, breakAL},
+	{0x0890, 0x0891, breakAL},
+	{0x0898, 0x089F, breakCM},
+	{0x08A0, 0x08C9, breakAL},
+	{0x08CA, 0x08E1, breakCM},
+	{0x08E2, 0x08E2, breakAL},
+	{0x08E3, 0x0903, breakCM},
+	{0x0904, 0x0939, breakAL},
+	{0x093A, 0x093C, breakCM},
+	{0x093D, 0x093D, breakAL},
+	{0x093E, 0x094F, breakCM},
+	{0x0950, 0x0950, breakAL},
+	{0x0951, 0x0957, breakCM},
+	{0x0958, 0x0961, breakAL},
+	{0x0962, 0x0963, breakCM},
+	{0x0964, 0x0965, breakBA},
+	{0x0966, 0x096F, breakNU},
+	{0x0970, 0x0980, breakAL},
+	{0x0981, 0x0983, breakCM},
+	{0x0985, 0x098C, breakAL},
+	{0x098F, 0x0990, breakAL},
+	{0x0993, 0x09A8, breakAL},
+	{0x09AA, 0x09B0, breakAL},
+	{0x09B2, 0x09B2, breakAL},
+	{0x09B6, 0x09B9, breakAL},
+	{0x09BC, 0x09BC, breakCM},
+	{0x09BD, 0x09BD, breakAL},
+	{0x09BE, 0x09C4, breakCM},
+	{0x09C7, 0x09C8, breakCM},
+	{0x09CB, 0x09CD, breakCM},
+	{0x09CE, 0x09CE, breakAL},
+	{0x09D7, 0x09D7, breakCM},
+	{0x09DC, 0x09DD, breakAL},
+	{0x09DF, 0x09E1, breakAL},
+	{0x09E2, 0x09E3, breakCM},
+	{0x09E6, 0x09EF, breakNU},
+	{0x09F0, 0x09F1, breakAL},
+	{0x09F2, 0x09F3, breakPO},
+	{0x09F4, 0x09F8, breakAL},
+	{0x09F9, 0x09F9, breakPO},
+	{0x09FA, 0x09FA, breakAL},
+	{0x09FB, 0x09FB, breakPR},
+	{0x09FC, 0x09FD, breakAL},
+	{0x09FE, 0x09FE, breakCM},
+	{0x0A01, 0x0A03, breakCM},
+	{0x0A05, 0x0A0A, breakAL},
+	{0x0A0F, 0x0A10, breakAL},
+	{0x0A13, 0x0A28, breakAL},
+	{0x0A2A, 0x0A30, breakAL},
+	{0x0A32, 0x0A33, breakAL},
+	{0x0A35, 0x0A36, breakAL},
+	{0x0A38, 0x0A39, breakAL},
+	{0x0A3C, 0x0A3C, breakCM},
+	{0x0A3E, 0x0A42, breakCM},
+	{0x0A47, 0x0A48, breakCM},
+	{0x0A4B, 0x0A4D, breakCM},
+	{0x0A51, 0x0A51, breakCM},
+	{0x0A59, 0x0A5C, breakAL},
+	{0x0A5E, 0x0A5E, breakAL},
+	{0x0A66, 0x0A6F, breakNU},
+	{0x0A70, 0x0A71, breakCM},
+	{0x0A72, 0x0A74, breakAL},
+	{0x0A75, 0x0A75, breakCM},
+	{0x0A76, 0x0A76, breakAL},
+	{0x0A81, 0x0A83, breakCM},
+	{0x0A85, 0x0A8D, breakAL},
+	{0x0A8F, 0x0A91, breakAL},
+	{0x0A93, 0x0AA8, breakAL},
+	{0x0AAA, 0x0AB0, breakAL},
+	{0x0AB2, 0x0AB3, breakAL},
+	{0x0AB5, 0x0AB9, breakAL},
+	{0x0ABC, 0x0ABC, breakCM},
+	{0x0ABD, 0x0ABD, breakAL},
+	{0x0ABE, 0x0AC5, breakCM},
+	{0x0AC7, 0x0AC9, breakCM},
+	{0x0ACB, 0x0ACD, breakCM},
+	{0x0AD0, 0x0AD0, breakAL},
+	{0x0AE0, 0x0AE1, breakAL},
+	{0x0AE2, 0x0AE3, breakCM},
+	{0x0AE6, 0x0AEF, breakNU},
+	{0x0AF0, 0x0AF0, breakAL},
+	{0x0AF1, 0x0AF1, breakPR},
+	{0x0AF9, 0x0AF9, breakAL},
+	{0x0AFA, 0x0AFF, breakCM},
+	{0x0B01, 0x0B03, breakCM},
+	{0x0B05, 0x0B0C, breakAL},
+	{0x0B0F, 0x0B10, breakAL},
+	{0x0B13, 0x0B28, breakAL},
+	{0x0B2A, 0x0B30, breakAL},
+	{0x0B32, 0x0B33, breakAL},
+	{0x0B35, 0x0B39, breakAL},
+	{0x0B3C, 0x0B3C, breakCM},
+	{0x0B3D, 0x0B3D, breakAL},
+	{0x0B3E, 0x0B44, breakCM},
+	{0x0B47, 0x0B48, breakCM},
+	{0x0B4B, 0x0B4D, breakCM},
+	{0x0B55, 0x0B57, breakCM},
+	{0x0B5C, 0x0B5D, breakAL},
+	{0x0B5F, 0x0B61, breakAL},
+	{0x0B62, 0x0B63, breakCM},
+	{0x0B66, 0x0B6F, breakNU},
+	{0x0B70, 0x0B77, breakAL},
+	{0x0B82, 0x0B82, breakCM},
+	{0x0B83, 0x0B83, breakAL},
+	{0x0B85, 0x0B8A, breakAL},
+	{0x0B8E, 0x0B90, breakAL},
+	{0x0B92, 0x0B95, breakAL},
+	{0x0B99, 0x0B9A, breakAL},
+	{0x0B9C, 0x0B9C, breakAL},
+	{0x0B9E, 0x0B9F, breakAL},
+	{0x0BA3, 0x0BA4, breakAL},
+	{0x0BA8, 0x0BAA, breakAL},
+	{0x0BAE, 0x0BB9, breakAL},
+	{0x0BBE, 0x0BC2, breakCM},
+	{0x0BC6, 0x0BC8, breakCM},
+	{0x0BCA, 0x0BCD, breakCM},
+	{0x0BD0, 0x0BD0, breakAL},
+	{0x0BD7, 0x0BD7, breakCM},
+	{0x0BE6, 0x0BEF, breakNU},
+	{0x0BF0, 0x0BF8, breakAL},
+	{0x0BF9, 0x0BF9, breakPR},
+	{0x0BFA, 0x0BFA, breakAL},
+	{0x0C00, 0x0C04, breakCM},
+	{0x0C05, 0x0C0C, breakAL},
+	{0x0C0E, 0x0C10, breakAL},
+	{0x0C12, 0x0C28, breakAL},
+	{0x0C2A, 0x0C39, breakAL},
+	{0x0C3C, 0x0C3C, breakCM},
+	{0x0C3D, 0x0C3D, breakAL},
+	{0x0C3E, 0x0C44, breakCM},
+	{0x0C46, 0x0C48, breakCM},
+	{0x0C4A, 0x0C4D, breakCM},
+	{0x0C55, 0x0C56, breakCM},
+	{0x0C58, 0x0C5A, breakAL},
+	{0x0C5D, 0x0C5D, breakAL},
+	{0x0C60, 0x0C61, breakAL},
+	{0x0C62, 0x0C63, breakCM},
+	{0x0C66, 0x0C6F, breakNU},
+	{0x0C77, 0x0C77, breakBB},
+	{0x0C78, 0x0C80, breakAL},
+	{0x0C81, 0x0C83, breakCM},
+	{0x0C84, 0x0C84, breakBB},
+	{0x0C85, 0x0C8C, breakAL},
+	{0x0C8E, 0x0C90, breakAL},
+	{0x0C92, 0x0CA8, breakAL},
+	{0x0CAA, 0x0CB3, breakAL},
+	{0x0CB5, 0x0CB9, breakAL},
+	{0x0CBC, 0x0CBC, breakCM},
+	{0x0CBD, 0x0CBD, breakAL},
+	{0x0CBE, 0x0CC4, breakCM},
+	{0x0CC6, 0x0CC8, breakCM},
+	{0x0CCA, 0x0CCD, breakCM},
+	{0x0CD5, 0x0CD6, breakCM},
+	{0x0CDD, 0x0CDE, breakAL},
+	{0x0CE0, 0x0CE1, breakAL},
+	{0x0CE2, 0x0CE3, breakCM},
+	{0x0CE6, 0x0CEF, breakNU},
+	{0x0CF1, 0x0CF2, breakAL},
+	{0x0CF3, 0x0CF3, breakCM},
+	{0x0D00, 0x0D03, breakCM},
+	{0x0D04, 0x0D0C, breakAL},
+	{0x0D0E, 0x0D10, breakAL},
+	{0x0D12, 0x0D3A, breakAL},
+	{0x0D3B, 0x0D3C, breakCM},
+	{0x0D3D, 0x0D3D, breakAL},
+	{0x0D3E, 0x0D44, breakCM},
+	{0x0D46, 0x0D48, breakCM},
+	{0x0D4A, 0x0D4D, breakCM},
+	{0x0D4E, 0x0D4F, breakAL},
+	{0x0D54, 0x0D56, breakAL},
+	{0x0D57, 0x0D57, breakCM},
+	{0x0D58, 0x0D61, breakAL},
+	{0x0D62, 0x0D63, breakCM},
+	{0x0D66, 0x0D6F, breakNU},
+	{0x0D70, 0x0D78, breakAL},
+	{0x0D79, 0x0D79, breakPO},
+	{0x0D7A, 0x0D7F, breakAL},
+	{0x0D81, 0x0D83, breakCM},
+	{0x0D85, 0x0D96, breakAL},
+	{0x0D9A, 0x0DB1, breakAL},
+	{0x0DB3, 0x0DBB, breakAL},
+	{0x0DBD, 0x0DBD, breakAL},
+	{0x0DC0, 0x0DC6, breakAL},
+	{0x0DCA, 0x0DCA, breakCM},
+	{0x0DCF, 0x0DD4, breakCM},
+	{0x0DD6, 0x0DD6, breakCM},
+	{0x0DD8, 0x0DDF, breakCM},
+	{0x0DE6, 0x0DEF, breakNU},
+	{0x0DF2, 0x0DF3, breakCM},
+	{0x0DF4, 0x0DF4, breakAL},
+	{0x0E01, 0x0E3A, breakSA},
+	{0x0E3F, 0x0E3F, breakPR},
+	{0x0E40, 0x0E4E, breakSA},
+	{0x0E4F, 0x0E4F, breakAL},
+	{0x0E50, 0x0E59, breakNU},
+	{0x0E5A, 0x0E5B, breakBA},
+	{0x0E81, 0x0E82, breakSA},
+	{0x0E84, 0x0E84, breakSA},
+	{0x0E86, 0x0E8A, breakSA},
+	{0x0E8C, 0x0EA3, breakSA},
+	{0x0EA5, 0x0EA5, breakSA},
+	{0x0EA7, 0x0EBD, breakSA},
+	{0x0EC0, 0x0EC4, breakSA},
+	{0x0EC6, 0x0EC6, breakSA},
+	{0x0EC8, 0x0ECE, breakSA},
+	{0x0ED0, 0x0ED9, breakNU},
+	{0x0EDC, 0x0EDF, breakSA},
+	{0x0F00, 0x0F00, breakAL},
+	{0x0F01, 0x0F04, breakBB},
+	{0x0F05, 0x0F05, breakAL},
+	{0x0F06, 0x0F07, breakBB},
+	{0x0F08, 0x0F08, breakGL},
+	{0x0F09, 0x0F0A, breakBB},
+	{0x0F0B, 0x0F0B, breakBA},
+	{0x0F0C, 0x0F0C, breakGL},
+	{0x0F0D, 0x0F11, breakEX},
+	{0x0F12, 0x0F12, breakGL},
+	{0x0F13, 0x0F13, breakAL},
+	{0x0F14, 0x0F14, breakEX},
+	{0x0F15, 0x0F17, breakAL},
+	{0x0F18, 0x0F19, breakCM},
+	{0x0F1A, 0x0F1F, breakAL},
+	{0x0F20, 0x0F29, breakNU},
+	{0x0F2A, 0x0F33, breakAL},
+	{0x0F34, 0x0F34, breakBA},
+	{0x0F35, 0x0F35, breakCM},
+	{0x0F36, 0x0F36, breakAL},
+	{0x0F37, 0x0F37, breakCM},
+	{0x0F38, 0x0F38, breakAL},
+	{0x0F39, 0x0F39, breakCM},
+	{0x0F3A, 0x0F3A, breakOP},
+	{0x0F3B, 0x0F3B, breakCL},
+	{0x0F3C, 0x0F3C, breakOP},
+	{0x0F3D, 0x0F3D, breakCL},
+	{0x0F3E, 0x0F3F, breakCM},
+	{0x0F40, 0x0F47, breakAL},
+	{0x0F49, 0x0F6C, breakAL},
+	{0x0F71, 0x0F7E, breakCM},
+	{0x0F7F, 0x0F7F, breakBA},
+	{0x0F80, 0x0F84, breakCM},
+	{0x0F85, 0x0F85, breakBA},
+	{0x0F86, 0x0F87, breakCM},
+	{0x0F88, 0x0F8C, breakAL},
+	{0x0F8D, 0x0F97, breakCM},
+	{0x0F99, 0x0FBC, breakCM},
+	{0x0FBE, 0x0FBF, breakBA},
+	{0x0FC0, 0x0FC5, breakAL},
+	{0x0FC6, 0x0FC6, breakCM},
+	{0x0FC7, 0x0FCC, breakAL},
+	{0x0FCE, 0x0FCF, breakAL},
+	{0x0FD0, 0x0FD1, breakBB},
+	{0x0FD2, 0x0FD2, breakBA},
+	{0x0FD3, 0x0FD3, breakBB},
+	{0x0FD4, 0x0FD8, breakAL},
+	{0x0FD9, 0x0FDA, breakGL},
+	{0x1000, 0x103F, breakSA},
+	{0x1040, 0x1049, breakNU},
+	{0x104A, 0x104B, breakBA},
+	{0x104C, 0x104F, breakAL},
+	{0x1050, 0x108F, breakSA},
+	{0x1090, 0x1099, breakNU},
+	{0x109A, 0x109F, breakSA},
+	{0x10A0, 0x10C5, breakAL},
+	{0x10C7, 0x10C7, breakAL},
+	{0x10CD, 0x10CD, breakAL},
+	{0x10D0, 0x10FF, breakAL},
+	{0x1100, 0x115F, breakJL},
+	{0x1160, 0x11A7, breakJV},
+	{0x11A8, 0x11FF, breakJT},
+	{0x1200, 0x1248, breakAL},
+	{0x124A, 0x124D, breakAL},
+	{0x1250, 0x1256, breakAL},
+	{0x1258, 0x1258, breakAL},
+	{0x125A, 0x125D, breakAL},
+	{0x1260, 0x1288, breakAL},
+	{0x128A, 0x128D, breakAL},
+	{0x1290, 0x12B0, breakAL},
+	{0x12B2, 0x12B5, breakAL},
+	{0x12B8, 0x12BE, breakAL},
+	{0x12C0, 0x12C0, breakAL},
+	{0x12C2, 0x12C5, breakAL},
+	{0x12C8, 0x12D6, breakAL},
+	{0x12D8, 0x1310, breakAL},
+	{0x1312, 0x1315, breakAL},
+	{0x1318, 0x135A, breakAL},
+	{0x135D, 0x135F, breakCM},
+	{0x1360, 0x1360, breakAL},
+	{0x1361, 0x1361, breakBA},
+	{0x1362, 0x137C, breakAL},
+	{0x1380, 0x1399, breakAL},
+	{0x13A0, 0x13F5, breakAL},
+	{0x13F8, 0x13FD, breakAL},
+	{0x1400, 0x1400, breakBA},
+	{0x1401, 0x167F, breakAL},
+	{0x1680, 0x1680, breakBA},
+	{0x1681, 0x169A, breakAL},
+	{0x169B, 0x169B, breakOP},
+	{0x169C, 0x169C, breakCL},
+	{0x16A0, 0x16EA, breakAL},
+	{0x16EB, 0x16ED, breakBA},
+	{0x16EE, 0x16F8, breakAL},
+	{0x1700, 0x1711, breakAL},
+	{0x1712, 0x1715, breakCM},
+	{0x171F, 0x1731, breakAL},
+	{0x1732, 0x1734, breakCM},
+	{0x1735, 0x1736, breakBA},
+	{0x1740, 0x1751, breakAL},
+	{0x1752, 0x1753, breakCM},
+	{0x1760, 0x176C, breakAL},
+	{0x176E, 0x1770, breakAL},
+	{0x1772, 0x1773, breakCM},
+	{0x1780, 0x17D3, breakSA},
+	{0x17D4, 0x17D5, breakBA},
+	{0x17D6, 0x17D6, breakNS},
+	{0x17D7, 0x17D7, breakSA},
+	{0x17D8, 0x17D8, breakBA},
+	{0x17D9, 0x17D9, breakAL},
+	{0x17DA, 0x17DA, breakBA},
+	{0x17DB, 0x17DB, breakPR},
+	{0x17DC, 0x17DD, breakSA},
+	{0x17E0, 0x17E9, breakNU},
+	{0x17F0, 0x17F9, breakAL},
+	{0x1800, 0x1801, breakAL},
+	{0x1802, 0x1803, breakEX},
+	{0x1804, 0x1805, breakBA},
+	{0x1806, 0x1806, breakBB},
+	{0x1807, 0x1807, breakAL},
+	{0x1808, 0x1809, breakEX},
+	{0x180A, 0x180A, breakAL},
+	{0x180B, 0x180D, breakCM},
+	{0x180E, 0x180E, breakGL},
+	{0x180F, 0x180F, breakCM},
+	{0x1810, 0x1819, breakNU},
+	{0x1820, 0x1878, breakAL},
+	{0x1880, 0x1884, breakAL},
+	{0x1885, 0x1886, breakCM},
+	{0x1887, 0x18A8, breakAL},
+	{0x18A9, 0x18A9, breakCM},
+	{0x18AA, 0x18AA, breakAL},
+	{0x18B0, 0x18F5, breakAL},
+	{0x1900, 0x191E, breakAL},
+	{0x1920, 0x192B, breakCM},
+	{0x1930, 0x193B, breakCM},
+	{0x1940, 0x1940, breakAL},
+	{0x1944, 0x1945, breakEX},
+	{0x1946, 0x194F, breakNU},
+	{0x1950, 0x196D, breakSA},
+	{0x1970, 0x1974, breakSA},
+	{0x1980, 0x19AB, breakSA},
+	{0x19B0, 0x19C9, breakSA},
+	{0x19D0, 0x19D9, breakNU},
+	{0x19DA, 0x19DA, breakSA},
+	{0x19DE, 0x19DF, breakSA},
+	{0x19E0, 0x1A16, breakAL},
+	{0x1A17, 0x1A1B, breakCM},
+	{0x1A1E, 0x1A1F, breakAL},
+	{0x1A20, 0x1A5E, breakSA},
+	{0x1A60, 0x1A7C, breakSA},
+	{0x1A7F, 0x1A7F, breakCM},
+	{0x1A80, 0x1A89, breakNU},
+	{0x1A90, 0x1A99, breakNU},
+	{0x1AA0, 0x1AAD, breakSA},
+	{0x1AB0, 0x1ACE, breakCM},
+	{0x1B00, 0x1B04, breakCM},
+	{0x1B05, 0x1B33, breakAL},
+	{0x1B34, 0x1B44, breakCM},
+	{0x1B45, 0x1B4C, breakAL},
+	{0x1B50, 0x1B59, breakNU},
+	{0x1B5A, 0x1B5B, breakBA},
+	{0x1B5C, 0x1B5C, breakAL},
+	{0x1B5D, 0x1B60, breakBA},
+	{0x1B61, 0x1B6A, breakAL},
+	{0x1B6B, 0x1B73, breakCM},
+	{0x1B74, 0x1B7C, breakAL},
+	{0x1B7D, 0x1B7E, breakBA},
+	{0x1B80, 0x1B82, breakCM},
+	{0x1B83, 0x1BA0, breakAL},
+	{0x1BA1, 0x1BAD, breakCM},
+	{0x1BAE, 0x1BAF, breakAL},
+	{0x1BB0, 0x1BB9, breakNU},
+	{0x1BBA, 0x1BE5, breakAL},
+	{0x1BE6, 0x1BF3, breakCM},
+	{0x1BFC, 0x1C23, breakAL},
+	{0x1C24, 0x1C37, breakCM},
+	{0x1C3B, 0x1C3F, breakBA},
+	{0x1C40, 0x1C49, breakNU},
+	{0x1C4D, 0x1C4F, breakAL},
+	{0x1C50, 0x1C59, breakNU},
+	{0x1C5A, 0x1C7D, breakAL},
+	{0x1C7E, 0x1C7F, breakBA},
+	{0x1C80, 0x1C88, breakAL},
+	{0x1C90, 0x1CBA, breakAL},
+	{0x1CBD, 0x1CC7, breakAL},
+	{0x1CD0, 0x1CD2, breakCM},
+	{0x1CD3, 0x1CD3, breakAL},
+	{0x1CD4, 0x1CE8, breakCM},
+	{0x1CE9, 0x1CEC, breakAL},
+	{0x1CED, 0x1CED, breakCM},
+	{0x1CEE, 0x1CF3, breakAL},
+	{0x1CF4, 0x1CF4, breakCM},
+	{0x1CF5, 0x1CF6, breakAL},
+	{0x1CF7, 0x1CF9, breakCM},
+	{0x1CFA, 0x1CFA, breakAL},
+	{0x1D00, 0x1DBF, breakAL},
+	{0x1DC0, 0x1DCC, breakCM},
+	{0x1DCD, 0x1DCD, breakGL},
+	{0x1DCE, 0x1DFB, breakCM},
+	{0x1DFC, 0x1DFC, breakGL},
+	{0x1DFD, 0x1DFF, breakCM},
+	{0x1E00, 0x1F15, breakAL},
+	{0x1F18, 0x1F1D, breakAL},
+	{0x1F20, 0x1F45, breakAL},
+	{0x1F48, 0x1F4D, breakAL},
+	{0x1F50, 0x1F57, breakAL},
+	{0x1F59, 0x1F59, breakAL},
+	{0x1F5B, 0x1F5B, breakAL},
+	{0x1F5D, 0x1F5D, breakAL},
+	{0x1F5F, 0x1F7D, breakAL},
+	{0x1F80, 0x1FB4, breakAL},
+	{0x1FB6, 0x1FC4, breakAL},
+	{0x1FC6, 0x1FD3, breakAL},
+	{0x1FD6, 0x1FDB, breakAL},
+	{0x1FDD, 0x1FEF, breakAL},
+	{0x1FF2, 0x1FF4, breakAL},
+	{0x1FF6, 0x1FFC, breakAL},
+	{0x1FFD, 0x1FFD, breakBB},
+	{0x1FFE, 0x1FFE, breakAL},
+	{0x2000, 0x2006, breakBA},
+	{0x2007, 0x2007, breakGL},
+	{0x2008, 0x200A, breakBA},
+	{0x200B, 0x200B, breakZW},
+	{0x200C, 0x200C, breakCM},
+	{0x200D, 0x200D, breakZWJ},
+	{0x200E, 0x200F, breakCM},
+	{0x2010, 0x2010, breakBA},
+	{0x2011, 0x2011, breakGL},
+	{0x2012, 0x2013, breakBA},
+	{0x2014, 0x2014, breakB2},
+	{0x2015, 0x2016, breakAI},
+	{0x2017, 0x2017, breakAL},
+	{0x2018, 0x2019, breakQU},
+	{0x201A, 0x201A, breakOP},
+	{0x201B, 0x201D, breakQU},
+	{0x201E, 0x201E, breakOP},
+	{0x201F, 0x201F, breakQU},
+	{0x2020, 0x2021, breakAI},
+	{0x2022, 0x2023, breakAL},
+	{0x2024, 0x2026, breakIN},
+	{0x2027, 0x2027, breakBA},
+	{0x2028, 0x2029, breakBK},
+	{0x202A, 0x202E, breakCM},
+	{0x202F, 0x202F, breakGL},
+	{0x2030, 0x2037, breakPO},
+	{0x2038, 0x2038, breakAL},
+	{0x2039, 0x203A, breakQU},
+	{0x203B, 0x203B, breakAI},
+	{0x203C, 0x203D, breakNS},
+	{0x203E, 0x2043, breakAL},
+	{0x2044, 0x2044, breakIS},
+	{0x2045, 0x2045, breakOP},
+	{0x2046, 0x2046, breakCL},
+	{0x2047, 0x2049, breakNS},
+	{0x204A, 0x2055, breakAL},
+	{0x2056, 0x2056, breakBA},
+	{0x2057, 0x2057, breakPO},
+	{0x2058, 0x205B, breakBA},
+	{0x205C, 0x205C, breakAL},
+	{0x205D, 0x205F, breakBA},
+	{0x2060, 0x2060, breakWJ},
+	{0x2061, 0x2064, breakAL},
+	{0x2066, 0x206F, breakCM},
+	{0x2070, 0x2071, breakAL},
+	{0x2074, 0x2074, breakAI},
+	{0x2075, 0x207C, breakAL},
+	{0x207D, 0x207D, breakOP},
+	{0x207E, 0x207E, breakCL},
+	{0x207F, 0x207F, breakAI},
+	{0x2080, 0x2080, breakAL},
+	{0x2081, 0x2084, breakAI},
+	{0x2085, 0x208C, breakAL},
+	{0x208D, 0x208D, breakOP},
+	{0x208E, 0x208E, breakCL},
+	{0x2090, 0x209C, breakAL},
+	{0x20A0, 0x20A6, breakPR},
+	{0x20A7, 0x20A7, breakPO},
+	{0x20A8, 0x20B5, breakPR},
+	{0x20B6, 0x20B6, breakPO},
+	{0x20B7, 0x20BA, breakPR},
+	{0x20BB, 0x20BB, breakPO},
+	{0x20BC, 0x20BD, breakPR},
+	{0x20BE, 0x20BE, breakPO},
+	{0x20BF, 0x20BF, breakPR},
+	{0x20C0, 0x20C0, breakPO},
+	{0x20C1, 0x20CF, breakPR},
+	{0x20D0, 0x20F0, breakCM},
+	{0x2100, 0x2102, breakAL},
+	{0x2103, 0x2103, breakPO},
+	{0x2104, 0x2104, breakAL},
+	{0x2105, 0x2105, breakAI},
+	{0x2106, 0x2108, breakAL},
+	{0x2109, 0x2109, breakPO},
+	{0x210A, 0x2112, breakAL},
+	{0x2113, 0x2113, breakAI},
+	{0x2114, 0x2115, breakAL},
+	{0x2116, 0x2116, breakPR},
+	{0x2117, 0x2120, breakAL},
+	{0x2121, 0x2122, breakAI},
+	{0x2123, 0x212A, breakAL},
+	{0x212B, 0x212B, breakAI},
+	{0x212C, 0x2153, breakAL},
+	{0x2154, 0x2155, breakAI},
+	{0x2156, 0x215A, breakAL},
+	{0x215B, 0x215B, breakAI},
+	{0x215C, 0x215D, breakAL},
+	{0x215E, 0x215E, breakAI},
+	{0x215F, 0x215F, breakAL},
+	{0x2160, 0x216B, breakAI},
+	{0x216C, 0x216F, breakAL},
+	{0x2170, 0x2179, breakAI},
+	{0x217A, 0x2188, breakAL},
+	{0x2189, 0x2189, breakAI},
+	{0x218A, 0x218B, breakAL},
+	{0x2190, 0x2199, breakAI},
+	{0x219A, 0x21D1, breakAL},
+	{0x21D2, 0x21D2, breakAI},
+	{0x21D3, 0x21D3, breakAL},
+	{0x21D4, 0x21D4, breakAI},
+	{0x21D5, 0x21FF, breakAL},
+	{0x2200, 0x2200, breakAI},
+	{0x2201, 0x2201, breakAL},
+	{0x2202, 0x2203, breakAI},
+	{0x2204, 0x2206, breakAL},
+	{0x2207, 0x2208, breakAI},
+	{0x2209, 0x220A, breakAL},
+	{0x220B, 0x220B, breakAI},
+	{0x220C, 0x220E, breakAL},
+	{0x220F, 0x220F, breakAI},
+	{0x2210, 0x2210, breakAL},
+	{0x2211, 0x2211, breakAI},
+	{0x2212, 0x2213, breakPR},
+	{0x2214, 0x2214, breakAL},
+	{0x2215, 0x2215, breakAI},
+	{0x2216, 0x2219, breakAL},
+	{0x221A, 0x221A, breakAI},
+	{0x221B, 0x221C, breakAL},
+	{0x221D, 0x2220, breakAI},
+	{0x2221, 0x2222, breakAL},
+	{0x2223, 0x2223, breakAI},
+	{0x2224, 0x2224, breakAL},
+	{0x2225, 0x2225, breakAI},
+	{0x2226, 0x2226, breakAL},
+	{0x2227, 0x222C, breakAI},
+	{0x222D, 0x222D, breakAL},
+	{0x222E, 0x222E, breakAI},
+	{0x222F, 0x2233, breakAL},
+	{0x2234, 0x2237, breakAI},
+	{0x2238, 0x223B, breakAL},
+	{0x223C, 0x223D, breakAI},
+	{0x223E, 0x2247, breakAL},
+	{0x2248, 0x2248, breakAI},
+	{0x2249, 0x224B, breakAL},
+	{0x224C, 0x224C, breakAI},
+	{0x224D, 0x2251, breakAL},
+	{0x2252, 0x2252, breakAI},
+	{0x2253, 0x225F, breakAL},
+	{0x2260, 0x2261, breakAI},
+	{0x2262, 0x2263, breakAL},
+	{0x2264, 0x2267, breakAI},
+	{0x2268, 0x2269, breakAL},
+	{0x226A, 0x226B, breakAI},
+	{0x226C, 0x226D, breakAL},
+	{0x226E, 0x226F, breakAI},
+	{0x2270, 0x2281, breakAL},
+	{0x2282, 0x2283, breakAI},
+	{0x2284, 0x2285, breakAL},
+	{0x2286, 0x2287, breakAI},
+	{0x2288, 0x2294, breakAL},
+	{0x2295, 0x2295, breakAI},
+	{0x2296, 0x2298, breakAL},
+	{0x2299, 0x2299, breakAI},
+	{0x229A, 0x22A4, breakAL},
+	{0x22A5, 0x22A5, breakAI},
+	{0x22A6, 0x22BE, breakAL},
+	{0x22BF, 0x22BF, breakAI},
+	{0x22C0, 0x22EE, breakAL},
+	{0x22EF, 0x22EF, breakIN},
+	{0x22F0, 0x2307, breakAL},
+	{0x2308, 0x2308, breakOP},
+	{0x2309, 0x2309, breakCL},
+	{0x230A, 0x230A, breakOP},
+	{0x230B, 0x230B, breakCL},
+	{0x230C, 0x2311, breakAL},
+	{0x2312, 0x2312, breakAI},
+	{0x2313, 0x2319, breakAL},
+	{0x231A, 0x231B, breakID},
+	{0x231C, 0x2328, breakAL},
+	{0x2329, 0x2329, breakOP},
+	{0x232A, 0x232A, breakCL},
+	{0x232B, 0x23EF, breakAL},
+	{0x23F0, 0x23F3, breakID},
+	{0x23F4, 0x2426, breakAL},
+	{0x2440, 0x244A, breakAL},
+	{0x2460, 0x24FE, breakAI},
+	{0x24FF, 0x24FF, breakAL},
+	{0x2500, 0x254B, breakAI},
+	{0x254C, 0x254F, breakAL},
+	{0x2550, 0x2574, breakAI},
+	{0x2575, 0x257F, breakAL},
+	{0x2580, 0x258F, breakAI},
+	{0x2590, 0x2591, breakAL},
+	{0x2592, 0x2595, breakAI},
+	{0x2596, 0x259F, breakAL},
+	{0x25A0, 0x25A1, breakAI},
+	{0x25A2, 0x25A2, breakAL},
+	{0x25A3, 0x25A9, breakAI},
+	{0x25AA, 0x25B1, breakAL},
+	{0x25B2, 0x25B3, breakAI},
+	{0x25B4, 0x25B5, breakAL},
+	{0x25B6, 0x25B7, breakAI},
+	{0x25B8, 0x25BB, breakAL},
+	{0x25BC, 0x25BD, breakAI},
+	{0x25BE, 0x25BF, breakAL},
+	{0x25C0, 0x25C1, breakAI},
+	{0x25C2, 0x25C5, breakAL},
+	{0x25C6, 0x25C8, breakAI},
+	{0x25C9, 0x25CA, breakAL},
+	{0x25CB, 0x25CB, breakAI},
+	{0x25CC, 0x25CD, breakAL},
+	{0x25CE, 0x25D1, breakAI},
+	{0x25D2, 0x25E1, breakAL},
+	{0x25E2, 0x25E5, breakAI},
+	{0x25E6, 0x25EE, breakAL},
+	{0x25EF, 0x25EF, breakAI},
+	{0x25F0, 0x25FF, breakAL},
+	{0x2600, 0x2603, breakID},
+	{0x2604, 0x2604, breakAL},
+	{0x2605, 0x2606, breakAI},
+	{0x2607, 0x2608, breakAL},
+	{0x2609, 0x2609, breakAI},
+	{0x260A, 0x260D, breakAL},
+	{0x260E, 0x260F, breakAI},
+	{0x2610, 0x2613, breakAL},
+	{0x2614, 0x2615, breakID},
+	{0x2616, 0x2617, breakAI},
+	{0x2618, 0x2618, breakID},
+	{0x2619, 0x2619, breakAL},
+	{0x261A, 0x261C, breakID},
+	{0x261D, 0x261D, breakEB},
+	{0x261E, 0x261F, breakID},
+	{0x2620, 0x2638, breakAL},
+	{0x2639, 0x263B, breakID},
+	{0x263C, 0x263F, breakAL},
+	{0x2640, 0x2640, breakAI},
+	{0x2641, 0x2641, breakAL},
+	{0x2642, 0x2642, breakAI},
+	{0x2643, 0x265F, breakAL},
+	{0x2660, 0x2661, breakAI},
+	{0x2662, 0x2662, breakAL},
+	{0x2663, 0x2665, breakAI},
+	{0x2666, 0x2666, breakAL},
+	{0x2667, 0x2667, breakAI},
+	{0x2668, 0x2668, breakID},
+	{0x2669, 0x266A, breakAI},
+	{0x266B, 0x266B, breakAL},
+	{0x266C, 0x266D, breakAI},
+	{0x266E, 0x266E, breakAL},
+	{0x266F, 0x266F, breakAI},
+	{0x2670, 0x267E, breakAL},
+	{0x267F, 0x267F, breakID},
+	{0x2680, 0x269D, breakAL},
+	{0x269E, 0x269F, breakAI},
+	{0x26A0, 0x26BC, breakAL},
+	{0x26BD, 0x26C8, breakID},
+	{0x26C9, 0x26CC, breakAI},
+	{0x26CD, 0x26CD, breakID},
+	{0x26CE, 0x26CE, breakAL},
+	{0x26CF, 0x26D1, breakID},
+	{0x26D2, 0x26D2, breakAI},
+	{0x26D3, 0x26D4, breakID},
+	{0x26D5, 0x26D7, breakAI},
+	{0x26D8, 0x26D9, breakID},
+	{0x26DA, 0x26DB, breakAI},
+	{0x26DC, 0x26DC, breakID},
+	{0x26DD, 0x26DE, breakAI},
+	{0x26DF, 0x26E1, breakID},
+	{0x26E2, 0x26E2, breakAL},
+	{0x26E3, 0x26E3, breakAI},
+	{0x26E4, 0x26E7, breakAL},
+	{0x26E8, 0x26E9, breakAI},
+	{0x26EA, 0x26EA, breakID},
+	{0x26EB, 0x26F0, breakAI},
+	{0x26F1, 0x26F5, breakID},
+	{0x26F6, 0x26F6, breakAI},
+	{0x26F7, 0x26F8, breakID},
+	{0x26F9, 0x26F9, breakEB},
+	{0x26FA, 0x26FA, breakID},
+	{0x26FB, 0x26FC, breakAI},
+	{0x26FD, 0x2704, breakID},
+	{0x2705, 0x2707, breakAL},
+	{0x2708, 0x2709, breakID},
+	{0x270A, 0x270D, breakEB},
+	{0x270E, 0x2756, breakAL},
+	{0x2757, 0x2757, breakAI},
+	{0x2758, 0x275A, breakAL},
+	{0x275B, 0x2760, breakQU},
+	{0x2761, 0x2761, breakAL},
+	{0x2762, 0x2763, breakEX},
+	{0x2764, 0x2764, breakID},
+	{0x2765, 0x2767, breakAL},
+	{0x2768, 0x2768, breakOP},
+	{0x2769, 0x2769, breakCL},
+	{0x276A, 0x276A, breakOP},
+	{0x276B, 0x276B, breakCL},
+	{0x276C, 0x276C, breakOP},
+	{0x276D, 0x276D, breakCL},
+	{0x276E, 0x276E, breakOP},
+	{0x276F, 0x276F, breakCL},
+	{0x2770, 0x2770, breakOP},
+	{0x2771, 0x2771, breakCL},
+	{0x2772, 0x2772, breakOP},
+	{0x2773, 0x2773, breakCL},
+	{0x2774, 0x2774, breakOP},
+	{0x2775, 0x2775, breakCL},
+	{0x2776, 0x2793, breakAI},
+	{0x2794, 0x27C4, breakAL},
+	{0x27C5, 0x27C5, breakOP},
+	{0x27C6, 0x27C6, breakCL},
+	{0x27C7, 0x27E5, breakAL},
+	{0x27E6, 0x27E6, breakOP},
+	{0x27E7, 0x27E7, breakCL},
+	{0x27E8, 0x27E8, breakOP},
+	{0x27E9, 0x27E9, breakCL},
+	{0x27EA, 0x27EA, breakOP},
+	{0x27EB, 0x27EB, breakCL},
+	{0x27EC, 0x27EC, breakOP},
+	{0x27ED, 0x27ED, breakCL},
+	{0x27EE, 0x27EE, breakOP},
+	{0x27EF, 0x27EF, breakCL},
+	{0x27F0, 0x2982, breakAL},
+	{0x2983, 0x2983, breakOP},
+	{0x2984, 0x2984, breakCL},
+	{0x2985, 0x2985, breakOP},
+	{0x2986, 0x2986, breakCL},
+	{0x2987, 0x2987, breakOP},
+	{0x2988, 0x2988, breakCL},
+	{0x2989, 0x2989, breakOP},
+	{0x298A, 0x298A, breakCL},
+	{0x298B, 0x298B, breakOP},
+	{0x298C, 0x298C, breakCL},
+	{0x298D, 0x298D, breakOP},
+	{0x298E, 0x298E, breakCL},
+	{0x298F, 0x298F, breakOP},
+	{0x2990, 0x2990, breakCL},
+	{0x2991, 0x2991, breakOP},
+	{0x2992, 0x2992, breakCL},
+	{0x2993, 0x2993, breakOP},
+	{0x2994, 0x2994, breakCL},
+	{0x2995, 0x2995, breakOP},
+	{0x2996, 0x2996, breakCL},
+	{0x2997, 0x2997, breakOP},
+	{0x2998, 0x2998, breakCL},
+	{0x2999, 0x29D7, breakAL},
+	{0x29D8, 0x29D8, breakOP},
+	{0x29D9, 0x29D9, breakCL},
+	{0x29DA, 0x29DA, breakOP},
+	{0x29DB, 0x29DB, breakCL},
+	{0x29DC, 0x29FB, breakAL},
+	{0x29FC, 0x29FC, breakOP},
+	{0x29FD, 0x29FD, breakCL},
+	{0x29FE, 0x2B54, breakAL},
+	{0x2B55, 0x2B59, breakAI},
+	{0x2B5A, 0x2B73, breakAL},
+	{0x2B76, 0x2B95, breakAL},
+	{0x2B97, 0x2CEE, breakAL},
+	{0x2CEF, 0x2CF1, breakCM},
+	{0x2CF2, 0x2CF3, breakAL},
+	{0x2CF9, 0x2CF9, breakEX},
+	{0x2CFA, 0x2CFC, breakBA},
+	{0x2CFD, 0x2CFD, breakAL},
+	{0x2CFE, 0x2CFE, breakEX},
+	{0x2CFF, 0x2CFF, breakBA},
+	{0x2D00, 0x2D25, breakAL},
+	{0x2D27, 0x2D27, breakAL},
+	{0x2D2D, 0x2D2D, breakAL},
+	{0x2D30, 0x2D67, breakAL},
+	{0x2D6F, 0x2D6F, breakAL},
+	{0x2D70, 0x2D70, breakBA},
+	{0x2D7F, 0x2D7F, breakCM},
+	{0x2D80, 0x2D96, breakAL},
+	{0x2DA0, 0x2DA6, breakAL},
+	{0x2DA8, 0x2DAE, breakAL},
+	{0x2DB0, 0x2DB6, breakAL},
+	{0x2DB8, 0x2DBE, breakAL},
+	{0x2DC0, 0x2DC6, breakAL},
+	{0x2DC8, 0x2DCE, breakAL},
+	{0x2DD0, 0x2DD6, breakAL},
+	{0x2DD8, 0x2DDE, breakAL},
+	{0x2DE0, 0x2DFF, breakCM},
+	{0x2E00, 0x2E0D, breakQU},
+	{0x2E0E, 0x2E15, breakBA},
+	{0x2E16, 0x2E16, breakAL},
+	{0x2E17, 0x2E17, breakBA},
+	{0x2E18, 0x2E18, breakOP},
+	{0x2E19, 0x2E19, breakBA},
+	{0x2E1A, 0x2E1B, breakAL},
+	{0x2E1C, 0x2E1D, breakQU},
+	{0x2E1E, 0x2E1F, breakAL},
+	{0x2E20, 0x2E21, breakQU},
+	{0x2E22, 0x2E22, breakOP},
+	{0x2E23, 0x2E23, breakCL},
+	{0x2E24, 0x2E24, breakOP},
+	{0x2E25, 0x2E25, breakCL},
+	{0x2E26, 0x2E26, breakOP},
+	{0x2E27, 0x2E27, breakCL},
+	{0x2E28, 0x2E28, breakOP},
+	{0x2E29, 0x2E29, breakCL},
+	{0x2E2A, 0x2E2D, breakBA},
+	{0x2E2E, 0x2E2E, breakEX},
+	{0x2E2F, 0x2E2F, breakAL},
+	{0x2E30, 0x2E31, breakBA},
+	{0x2E32, 0x2E32, breakAL},
+	{0x2E33, 0x2E34, breakBA},
+	{0x2E35, 0x2E39, breakAL},
+	{0x2E3A, 0x2E3B, breakB2},
+	{0x2E3C, 0x2E3E, breakBA},
+	{0x2E3F, 0x2E3F, breakAL},
+	{0x2E40, 0x2E41, breakBA},
+	{0x2E42, 0x2E42, breakOP},
+	{0x2E43, 0x2E4A, breakBA},
+	{0x2E4B, 0x2E4B, breakAL},
+	{0x2E4C, 0x2E4C, breakBA},
+	{0x2E4D, 0x2E4D, breakAL},
+	{0x2E4E, 0x2E4F, breakBA},
+	{0x2E50, 0x2E52, breakAL},
+	{0x2E53, 0x2E54, breakEX},
+	{0x2E55, 0x2E55, breakOP},
+	{0x2E56, 0x2E56, breakCL},
+	{0x2E57, 0x2E57, breakOP},
+	{0x2E58, 0x2E58, breakCL},
+	{0x2E59, 0x2E59, breakOP},
+	{0x2E5A, 0x2E5A, breakCL},
+	{0x2E5B, 0x2E5B, breakOP},
+	{0x2E5C, 0x2E5C, breakCL},
+	{0x2E5D, 0x2E5D, breakBA},
+	{0x2E80, 0x2E99, breakID},
+	{0x2E9B, 0x2EF3, breakID},
+	{0x2F00, 0x2FD5, breakID},
+	{0x2FF0, 0x2FFB, breakID},
+	{0x3000, 0x3000, breakBA},
+	{0x3001, 0x3002, breakCL},
+	{0x3003, 0x3004, breakID},
+	{0x3005, 0x3005, breakNS},
+	{0x3006, 0x3007, breakID},
+	{0x3008, 0x3008, breakOP},
+	{0x3009, 0x3009, breakCL},
+	{0x300A, 0x300A, breakOP},
+	{0x300B, 0x300B, breakCL},
+	{0x300C, 0x300C, breakOP},
+	{0x300D, 0x300D, breakCL},
+	{0x300E, 0x300E, breakOP},
+	{0x300F, 0x300F, breakCL},
+	{0x3010, 0x3010, breakOP},
+	{0x3011, 0x3011, breakCL},
+	{0x3012, 0x3013, breakID},
+	{0x3014, 0x3014, breakOP},
+	{0x3015, 0x3015, breakCL},
+	{0x3016, 0x3016, breakOP},
+	{0x3017, 0x3017, breakCL},
+	{0x3018, 0x3018, breakOP},
+	{0x3019, 0x3019, breakCL},
+	{0x301A, 0x301A, breakOP},
+	{0x301B, 0x301B, breakCL},
+	{0x301C, 0x301C, breakNS},
+	{0x301D, 0x301D, breakOP},
+	{0x301E, 0x301F, breakCL},
+	{0x3020, 0x3029, breakID},
+	{0x302A, 0x302F, breakCM},
+	{0x3030, 0x3034, breakID},
+	{0x3035, 0x3035, breakCM},
+	{0x3036, 0x303A, breakID},
+	{0x303B, 0x303C, breakNS},
+	{0x303D, 0x303F, breakID},
+	{0x3041, 0x3041, breakCJ},
+	{0x3042, 0x3042, breakID},
+	{0x3043, 0x3043, breakCJ},
+	{0x3044, 0x3044, breakID},
+	{0x3045, 0x3045, breakCJ},
+	{0x3046, 0x3046, breakID},
+	{0x3047, 0x3047, breakCJ},
+	{0x3048, 0x3048, breakID},
+	{0x3049, 0x3049, breakCJ},
+	{0x304A, 0x3062, breakID},
+	{0x3063, 0x3063, breakCJ},
+	{0x3064, 0x3082, breakID},
+	{0x3083, 0x3083, breakCJ},
+	{0x3084, 0x3084, breakID},
+	{0x3085, 0x3085, breakCJ},
+	{0x3086, 0x3086, breakID},
+	{0x3087, 0x3087, breakCJ},
+	{0x3088, 0x308D, breakID},
+	{0x308E, 0x308E, breakCJ},
+	{0x308F, 0x3094, breakID},
+	{0x3095, 0x3096, breakCJ},
+	{0x3099, 0x309A, breakCM},
+	{0x309B, 0x309E, breakNS},
+	{0x309F, 0x309F, breakID},
+	{0x30A0, 0x30A0, breakNS},
+	{0x30A1, 0x30A1, breakCJ},
+	{0x30A2, 0x30A2, breakID},
+	{0x30A3, 0x30A3, breakCJ},
+	{0x30A4, 0x30A4, breakID},
+	{0x30A5, 0x30A5, breakCJ},
+	{0x30A6, 0x30A6, breakID},
+	{0x30A7, 0x30A7, breakCJ},
+	{0x30A8, 0x30A8, breakID},
+	{0x30A9, 0x30A9, breakCJ},
+	{0x30AA, 0x30C2, breakID},
+	{0x30C3, 0x30C3, breakCJ},
+	{0x30C4, 0x30E2, breakID},
+	{0x30E3, 0x30E3, breakCJ},
+	{0x30E4, 0x30E4, breakID},
+	{0x30E5, 0x30E5, breakCJ},
+	{0x30E6, 0x30E6, breakID},
+	{0x30E7, 0x30E7, breakCJ},
+	{0x30E8, 0x30ED, breakID},
+	{0x30EE, 0x30EE, breakCJ},
+	{0x30EF, 0x30F4, breakID},
+	{0x30F5, 0x30F6, breakCJ},
+	{0x30F7, 0x30FA, breakID},
+	{0x30FB, 0x30FB, breakNS},
+	{0x30FC, 0x30FC, breakCJ},
+	{0x30FD, 0x30FE, breakNS},
+	{0x30FF, 0x30FF, breakID},
+	{0x3105, 0x312F, breakID},
+	{0x3131, 0x318E, breakID},
+	{0x3190, 0x31E3, breakID},
+	{0x31F0, 0x31FF, breakCJ},
+	{0x3200, 0x321E, breakID},
+	{0x3220, 0x3247, breakID},
+	{0x3248, 0x324F, breakAI},
+	{0x3250, 0x4DBF, breakID},
+	{0x4DC0, 0x4DFF, breakAL},
+	{0x4E00, 0xA014, breakID},
+	{0xA015, 0xA015, breakNS},
+	{0xA016, 0xA48C, breakID},
+	{0xA490, 0xA4C6, breakID},
+	{0xA4D0, 0xA4FD, breakAL},
+	{0xA4FE, 0xA4FF, breakBA},
+	{0xA500, 0xA60C, breakAL},
+	{0xA60D, 0xA60D, breakBA},
+	{0xA60E, 0xA60E, breakEX},
+	{0xA60F, 0xA60F, breakBA},
+	{0xA610, 0xA61F, breakAL},
+	{0xA620, 0xA629, breakNU},
+	{0xA62A, 0xA62B, breakAL},
+	{0xA640, 0xA66E, breakAL},
+	{0xA66F, 0xA672, breakCM},
+	{0xA673, 0xA673, breakAL},
+	{0xA674, 0xA67D, breakCM},
+	{0xA67E, 0xA69D, breakAL},
+	{0xA69E, 0xA69F, breakCM},
+	{0xA6A0, 0xA6EF, breakAL},
+	{0xA6F0, 0xA6F1, breakCM},
+	{0xA6F2, 0xA6F2, breakAL},
+	{0xA6F3, 0xA6F7, breakBA},
+	{0xA700, 0xA7CA, breakAL},
+	{0xA7D0, 0xA7D1, breakAL},
+	{0xA7D3, 0xA7D3, breakAL},
+	{0xA7D5, 0xA7D9, breakAL},
+	{0xA7F2, 0xA801, breakAL},
+	{0xA802, 0xA802, breakCM},
+	{0xA803, 0xA805, breakAL},
+	{0xA806, 0xA806, breakCM},
+	{0xA807, 0xA80A, breakAL},
+	{0xA80B, 0xA80B, breakCM},
+	{0xA80C, 0xA822, breakAL},
+	{0xA823, 0xA827, breakCM},
+	{0xA828, 0xA82B, breakAL},
+	{0xA82C, 0xA82C, breakCM},
+	{0xA830, 0xA837, breakAL},
+	{0xA838, 0xA838, breakPO},
+	{0xA839, 0xA839, breakAL},
+	{0xA840, 0xA873, breakAL},
+	{0xA874, 0xA875, breakBB},
+	{0xA876, 0xA877, breakEX},
+	{0xA880, 0xA881, breakCM},
+	{0xA882, 0xA8B3, breakAL},
+	{0xA8B4, 0xA8C5, breakCM},
+	{0xA8CE, 0xA8CF, breakBA},
+	{0xA8D0, 0xA8D9, breakNU},
+	{0xA8E0, 0xA8F1, breakCM},
+	{0xA8F2, 0xA8FB, breakAL},
+	{0xA8FC, 0xA8FC, breakBB},
+	{0xA8FD, 0xA8FE, breakAL},
+	{0xA8FF, 0xA8FF, breakCM},
+	{0xA900, 0xA909, breakNU},
+	{0xA90A, 0xA925, breakAL},
+	{0xA926, 0xA92D, breakCM},
+	{0xA92E, 0xA92F, breakBA},
+	{0xA930, 0xA946, breakAL},
+	{0xA947, 0xA953, breakCM},
+	{0xA95F, 0xA95F, breakAL},
+	{0xA960, 0xA97C, breakJL},
+	{0xA980, 0xA983, breakCM},
+	{0xA984, 0xA9B2, breakAL},
+	{0xA9B3, 0xA9C0, breakCM},
+	{0xA9C1, 0xA9C6, breakAL},
+	{0xA9C7, 0xA9C9, breakBA},
+	{0xA9CA, 0xA9CD, breakAL},
+	{0xA9CF, 0xA9CF, breakAL},
+	{0xA9D0, 0xA9D9, breakNU},
+	{0xA9DE, 0xA9DF, breakAL},
+	{0xA9E0, 0xA9EF, breakSA},
+	{0xA9F0, 0xA9F9, breakNU},
+	{0xA9FA, 0xA9FE, breakSA},
+	{0xAA00, 0xAA28, breakAL},
+	{0xAA29, 0xAA36, breakCM},
+	{0xAA40, 0xAA42, breakAL},
+	{0xAA43, 0xAA43, breakCM},
+	{0xAA44, 0xAA4B, breakAL},
+	{0xAA4C, 0xAA4D, breakCM},
+	{0xAA50, 0xAA59, breakNU},
+	{0xAA5C, 0xAA5C, breakAL},
+	{0xAA5D, 0xAA5F, breakBA},
+	{0xAA60, 0xAAC2, breakSA},
+	{0xAADB, 0xAADF, breakSA},
+	{0xAAE0, 0xAAEA, breakAL},
+	{0xAAEB, 0xAAEF, breakCM},
+	{0xAAF0, 0xAAF1, breakBA},
+	{0xAAF2, 0xAAF4, breakAL},
+	{0xAAF5, 0xAAF6, breakCM},
+	{0xAB01, 0xAB06, breakAL},
+	{0xAB09, 0xAB0E, breakAL},
+	{0xAB11, 0xAB16, breakAL},
+	{0xAB20, 0xAB26, breakAL},
+	{0xAB28, 0xAB2E, breakAL},
+	{0xAB30, 0xAB6B, breakAL},
+	{0xAB70, 0xABE2, breakAL},
+	{0xABE3, 0xABEA, breakCM},
+	{0xABEB, 0xABEB, breakBA},
+	{0xABEC, 0xABED, breakCM},
+	{0xABF0, 0xABF9, breakNU},
+	{0xAC00, 0xAC00, breakH2},
+	{0xAC01, 0xAC1B, breakH3},
+	{0xAC1C, 0xAC1C, breakH2},
+	{0xAC1D, 0xAC37, breakH3},
+	{0xAC38, 0xAC38, breakH2},
+	{0xAC39, 0xAC53, breakH3},
+	{0xAC54, 0xAC54, breakH2},
+	{0xAC55, 0xAC6F, breakH3},
+	{0xAC70, 0xAC70, breakH2},
+	{0xAC71, 0xAC8B, breakH3},
+	{0xAC8C, 0xAC8C, breakH2},
+	{0xAC8D, 0xACA7, breakH3},
+	{0xACA8, 0xACA8, breakH2},
+	{0xACA9, 0xACC3, breakH3},
+	{0xACC4, 0xACC4, breakH2},
+	{0xACC5, 0xACDF, breakH3},
+	{0xACE0, 0xACE0, breakH2},
+	{0xACE1, 0xACFB, breakH3},
+	{0xACFC, 0xACFC, breakH2},
+	{0xACFD, 0xAD17, breakH3},
+	{0xAD18, 0xAD18, breakH2},
+	{0xAD19, 0xAD33, breakH3},
+	{0xAD34, 0xAD34, breakH2},
+	{0xAD35, 0xAD4F, breakH3},
+	{0xAD50, 0xAD50, breakH2},
+	{0xAD51, 0xAD6B, breakH3},
+	{0xAD6C, 0xAD6C, breakH2},
+	{0xAD6D, 0xAD87, breakH3},
+	{0xAD88, 0xAD88, breakH2},
+	{0xAD89, 0xADA3, breakH3},
+	{0xADA4, 0xADA4, breakH2},
+	{0xADA5, 0xADBF, breakH3},
+	{0xADC0, 0xADC0, breakH2},
+	{0xADC1, 0xADDB, breakH3},
+	{0xADDC, 0xADDC, breakH2},
+	{0xADDD, 0xADF7, breakH3},
+	{0xADF8, 0xADF8, breakH2},
+	{0xADF9, 0xAE13, breakH3},
+	{0xAE14, 0xAE14, breakH2},
+	{0xAE15, 0xAE2F, breakH3},
+	{0xAE30, 0xAE30, breakH2},
+	{0xAE31, 0xAE4B, breakH3},
+	{0xAE4C, 0xAE4C, breakH2},
+	{0xAE4D, 0xAE67, breakH3},
+	{0xAE68, 0xAE68, breakH2},
+	{0xAE69, 0xAE83, breakH3},
+	{0xAE84, 0xAE84, breakH2},
+	{0xAE85, 0xAE9F, breakH3},
+	{0xAEA0, 0xAEA0, breakH2},
+	{0xAEA1, 0xAEBB, breakH3},
+	{0xAEBC, 0xAEBC, breakH2},
+	{0xAEBD, 0xAED7, breakH3},
+	{0xAED8, 0xAED8, breakH2},
+	{0xAED9, 0xAEF3, breakH3},
+	{0xAEF4, 0xAEF4, breakH2},
+	{0xAEF5, 0xAF0F, breakH3},
+	{0xAF10, 0xAF10, breakH2},
+	{0xAF11, 0xAF2B, breakH3},
+	{0xAF2C, 0xAF2C, breakH2},
+	{0xAF2D, 0xAF47, breakH3},
+	{0xAF48, 0xAF48, breakH2},
+	{0xAF49, 0xAF63, breakH3},
+	{0xAF64, 0xAF64, breakH2},
+	{0xAF65, 0xAF7F, breakH3},
+	{0xAF80, 0xAF80, breakH2},
+	{0xAF81, 0xAF9B, breakH3},
+	{0xAF9C, 0xAF9C, breakH2},
+	{0xAF9D, 0xAFB7, breakH3},
+	{0xAFB8, 0xAFB8, breakH2},
+	{0xAFB9, 0xAFD3, breakH3},
+	{0xAFD4, 0xAFD4, breakH2},
+	{0xAFD5, 0xAFEF, breakH3},
+	{0xAFF0, 0xAFF0, breakH2},
+	{0xAFF1, 0xB00B, breakH3},
+	{0xB00C, 0xB00C, breakH2},
+	{0xB00D, 0xB027, breakH3},
+	{0xB028, 0xB028, breakH2},
+	{0xB029, 0xB043, breakH3},
+	{0xB044, 0xB044, breakH2},
+	{0xB045, 0xB05F, breakH3},
+	{0xB060, 0xB060, breakH2},
+	{0xB061, 0xB07B, breakH3},
+	{0xB07C, 0xB07C, breakH2},
+	{0xB07D, 0xB097, breakH3},
+	{0xB098, 0xB098, breakH2},
+	{0xB099, 0xB0B3, breakH3},
+	{0xB0B4, 0xB0B4, breakH2},
+	{0xB0B5, 0xB0CF, breakH3},
+	{0xB0D0, 0xB0D0, breakH2},
+	{0xB0D1, 0xB0EB, breakH3},
+	{0xB0EC, 0xB0EC, breakH2},
+	{0xB0ED, 0xB107, breakH3},
+	{0xB108, 0xB108, breakH2},
+	{0xB109, 0xB123, breakH3},
+	{0xB124, 0xB124, breakH2},
+	{0xB125, 0xB13F, breakH3},
+	{0xB140, 0xB140, breakH2},
+	{0xB141, 0xB15B, breakH3},
+	{0xB15C, 0xB15C, breakH2},
+	{0xB15D, 0xB177, breakH3},
+	{0xB178, 0xB178, breakH2},
+	{0xB179, 0xB193, breakH3},
+	{0xB194, 0xB194, breakH2},
+	{0xB195, 0xB1AF, breakH3},
+	{0xB1B0, 0xB1B0, breakH2},
+	{0xB1B1, 0xB1CB, breakH3},
+	{0xB1CC, 0xB1CC, breakH2},
+	{0xB1CD, 0xB1E7, breakH3},
+	{0xB1E8, 0xB1E8, breakH2},
+	{0xB1E9, 0xB203, breakH3},
+	{0xB204, 0xB204, breakH2},
+	{0xB205, 0xB21F, breakH3},
+	{0xB220, 0xB220, breakH2},
+	{0xB221, 0xB23B, breakH3},
+	{0xB23C, 0xB23C, breakH2},
+	{0xB23D, 0xB257, breakH3},
+	{0xB258, 0xB258, breakH2},
+	{0xB259, 0xB273, breakH3},
+	{0xB274, 0xB274, breakH2},
+	{0xB275, 0xB28F, breakH3},
+	{0xB290, 0xB290, breakH2},
+	{0xB291, 0xB2AB, breakH3},
+	{0xB2AC, 0xB2AC, breakH2},
+	{0xB2AD, 0xB2C7, breakH3},
+	{0xB2C8, 0xB2C8, breakH2},
+	{0xB2C9, 0xB2E3, breakH3},
+	{0xB2E4, 0xB2E4, breakH2},
+	{0xB2E5, 0xB2FF, breakH3},
+	{0xB300, 0xB300, breakH2},
+	{0xB301, 0xB31B, breakH3},
+	{0xB31C, 0xB31C, breakH2},
+	{0xB31D, 0xB337, breakH3},
+	{0xB338, 0xB338, breakH2},
+	{0xB339, 0xB353, breakH3},
+	{0xB354, 0xB354, breakH2},
+	{0xB355, 0xB36F, breakH3},
+	{0xB370, 0xB370, breakH2},
+	{0xB371, 0xB38B, breakH3},
+	{0xB38C, 0xB38C, breakH2},
+	{0xB38D, 0xB3A7, breakH3},
+	{0xB3A8, 0xB3A8, breakH2},
+	{0xB3A9, 0xB3C3, breakH3},
+	{0xB3C4, 0xB3C4, breakH2},
+	{0xB3C5, 0xB3DF, breakH3},
+	{0xB3E0, 0xB3E0, breakH2},
+	{0xB3E1, 0xB3FB, breakH3},
+	{0xB3FC, 0xB3FC, breakH2},
+	{0xB3FD, 0xB417, breakH3},
+	{0xB418, 0xB418, breakH2},
+	{0xB419, 0xB433, breakH3},
+	{0xB434, 0xB434, breakH2},
+	{0xB435, 0xB44F, breakH3},
+	{0xB450, 0xB450, breakH2},
+	{0xB451, 0xB46B, breakH3},
+	{0xB46C, 0xB46C, breakH2},
+	{0xB46D, 0xB487, breakH3},
+	{0xB488, 0xB488, breakH2},
+	{0xB489, 0xB4A3, breakH3},
+	{0xB4A4, 0xB4A4, breakH2},
+	{0xB4A5, 0xB4BF, breakH3},
+	{0xB4C0, 0xB4C0, breakH2},
+	{0xB4C1, 0xB4DB, breakH3},
+	{0xB4DC, 0xB4DC, breakH2},
+	{0xB4DD, 0xB4F7, breakH3},
+	{0xB4F8, 0xB4F8, breakH2},
+	{0xB4F9, 0xB513, breakH3},
+	{0xB514, 0xB514, breakH2},
+	{0xB515, 0xB52F, breakH3},
+	{0xB530, 0xB530, breakH2},
+	{0xB531, 0xB54B, breakH3},
+	{0xB54C, 0xB54C, breakH2},
+	{0xB54D, 0xB567, breakH3},
+	{0xB568, 0xB568, breakH2},
+	{0xB569, 0xB583, breakH3},
+	{0xB584, 0xB584, breakH2},
+	{0xB585, 0xB59F, breakH3},
+	{0xB5A0, 0xB5A0, breakH2},
+	{0xB5A1, 0xB5BB, breakH3},
+	{0xB5BC, 0xB5BC, breakH2},
+	{0xB5BD, 0xB5D7, breakH3},
+	{0xB5D8, 0xB5D8, breakH2},
+	{0xB5D9, 0xB5F3, breakH3},
+	{0xB5F4, 0xB5F4, breakH2},
+	{0xB5F5, 0xB60F, breakH3},
+	{0xB610, 0xB610, breakH2},
+	{0xB611, 0xB62B, breakH3},
+	{0xB62C, 0xB62C, breakH2},
+	{0xB62D, 0xB647, breakH3},
+	{0xB648, 0xB648, breakH2},
+	{0xB649, 0xB663, breakH3},
+	{0xB664, 0xB664, breakH2},
+	{0xB665, 0xB67F, breakH3},
+	{0xB680, 0xB680, breakH2},
+	{0xB681, 0xB69B, breakH3},
+	{0xB69C, 0xB69C, breakH2},
+	{0xB69D, 0xB6B7, breakH3},
+	{0xB6B8, 0xB6B8, breakH2},
+	{0xB6B9, 0xB6D3, breakH3},
+	{0xB6D4, 0xB6D4, breakH2},
+	{0xB6D5, 0xB6EF, breakH3},
+	{0xB6F0, 0xB6F0, breakH2},
+	{0xB6F1, 0xB70B, breakH3},
+	{0xB70C, 0xB70C, breakH2},
+	{0xB70D, 0xB727, breakH3},
+	{0xB728, 0xB728, breakH2},
+	{0xB729, 0xB743, breakH3},
+	{0xB744, 0xB744, breakH2},
+	{0xB745, 0xB75F, breakH3},
+	{0xB760, 0xB760, breakH2},
+	{0xB761, 0xB77B, breakH3},
+	{0xB77C, 0xB77C, breakH2},
+	{0xB77D, 0xB797, breakH3},
+	{0xB798, 0xB798, breakH2},
+	{0xB799, 0xB7B3, breakH3},
+	{0xB7B4, 0xB7B4, breakH2},
+	{0xB7B5, 0xB7CF, breakH3},
+	{0xB7D0, 0xB7D0, breakH2},
+	{0xB7D1, 0xB7EB, breakH3},
+	{0xB7EC, 0xB7EC, breakH2},
+	{0xB7ED, 0xB807, breakH3},
+	{0xB808, 0xB808, breakH2},
+	{0xB809, 0xB823, breakH3},
+	{0xB824, 0xB824, breakH2},
+	{0xB825, 0xB83F, breakH3},
+	{0xB840, 0xB840, breakH2},
+	{0xB841, 0xB85B, breakH3},
+	{0xB85C, 0xB85C, breakH2},
+	{0xB85D, 0xB877, breakH3},
+	{0xB878, 0xB878, breakH2},
+	{0xB879, 0xB893, breakH3},
+	{0xB894, 0xB894, breakH2},
+	{0xB895, 0xB8AF, breakH3},
+	{0xB8B0, 0xB8B0, breakH2},
+	{0xB8B1, 0xB8CB, breakH3},
+	{0xB8CC, 0xB8CC, breakH2},
+	{0xB8CD, 0xB8E7, breakH3},
+	{0xB8E8, 0xB8E8, breakH2},
+	{0xB8E9, 0xB903, breakH3},
+	{0xB904, 0xB904, breakH2},
+	{0xB905, 0xB91F, breakH3},
+	{0xB920, 0xB920, breakH2},
+	{0xB921, 0xB93B, breakH3},
+	{0xB93C, 0xB93C, breakH2},
+	{0xB93D, 0xB957, breakH3},
+	{0xB958, 0xB958, breakH2},
+	{0xB959, 0xB973, breakH3},
+	{0xB974, 0xB974, breakH2},
+	{0xB975, 0xB98F, breakH3},
+	{0xB990, 0xB990, breakH2},
+	{0xB991, 0xB9AB, breakH3},
+	{0xB9AC, 0xB9AC, breakH2},
+	{0xB9AD, 0xB9C7, breakH3},
+	{0xB9C8, 0xB9C8, breakH2},
+	{0xB9C9, 0xB9E3, breakH3},
+	{0xB9E4, 0xB9E4, breakH2},
+	{0xB9E5, 0xB9FF, breakH3},
+	{0xBA00, 0xBA00, breakH2},
+	{0xBA01, 0xBA1B, breakH3},
+	{0xBA1C, 0xBA1C, breakH2},
+	{0xBA1D, 0xBA37, breakH3},
+	{0xBA38, 0xBA38, breakH2},
+	{0xBA39, 0xBA53, breakH3},
+	{0xBA54, 0xBA54, breakH2},
+	{0xBA55, 0xBA6F, breakH3},
+	{0xBA70, 0xBA70, breakH2},
+	{0xBA71, 0xBA8B, breakH3},
+	{0xBA8C, 0xBA8C, breakH2},
+	{0xBA8D, 0xBAA7, breakH3},
+	{0xBAA8, 0xBAA8, breakH2},
+	{0xBAA9, 0xBAC3, breakH3},
+	{0xBAC4, 0xBAC4, breakH2},
+	{0xBAC5, 0xBADF, breakH3},
+	{0xBAE0, 0xBAE0, breakH2},
+	{0xBAE1, 0xBAFB, breakH3},
+	{0xBAFC, 0xBAFC, breakH2},
+	{0xBAFD, 0xBB17, breakH3},
+	{0xBB18, 0xBB18, breakH2},
+	{0xBB19, 0xBB33, breakH3},
+	{0xBB34, 0xBB34, breakH2},
+	{0xBB35, 0xBB4F, breakH3},
+	{0xBB50, 0xBB50, breakH2},
+	{0xBB51, 0xBB6B, breakH3},
+	{0xBB6C, 0xBB6C, breakH2},
+	{0xBB6D, 0xBB87, breakH3},
+	{0xBB88, 0xBB88, breakH2},
+	{0xBB89, 0xBBA3, breakH3},
+	{0xBBA4, 0xBBA4, breakH2},
+	{0xBBA5, 0xBBBF, breakH3},
+	{0xBBC0, 0xBBC0, breakH2},
+	{0xBBC1, 0xBBDB, breakH3},
+	{0xBBDC, 0xBBDC, breakH2},
+	{0xBBDD, 0xBBF7, breakH3},
+	{0xBBF8, 0xBBF8, breakH2},
+	{0xBBF9, 0xBC13, breakH3},
+	{0xBC14, 0xBC14, breakH2},
+	{0xBC15, 0xBC2F, breakH3},
+	{0xBC30, 0xBC30, breakH2},
+	{0xBC31, 0xBC4B, breakH3},
+	{0xBC4C, 0xBC4C, breakH2},
+	{0xBC4D, 0xBC67, breakH3},
+	{0xBC68, 0xBC68, breakH2},
+	{0xBC69, 0xBC83, breakH3},
+	{0xBC84, 0xBC84, breakH2},
+	{0xBC85, 0xBC9F, breakH3},
+	{0xBCA0, 0xBCA0, breakH2},
+	{0xBCA1, 0xBCBB, breakH3},
+	{0xBCBC, 0xBCBC, breakH2},
+	{0xBCBD, 0xBCD7, breakH3},
+	{0xBCD8, 0xBCD8, breakH2},
+	{0xBCD9, 0xBCF3, breakH3},
+	{0xBCF4, 0xBCF4, breakH2},
+	{0xBCF5, 0xBD0F, breakH3},
+	{0xBD10, 0xBD10, breakH2},
+	{0xBD11, 0xBD2B, breakH3},
+	{0xBD2C, 0xBD2C, breakH2},
+	{0xBD2D, 0xBD47, breakH3},
+	{0xBD48, 0xBD48, breakH2},
+	{0xBD49, 0xBD63, breakH3},
+	{0xBD64, 0xBD64, breakH2},
+	{0xBD65, 0xBD7F, breakH3},
+	{0xBD80, 0xBD80, breakH2},
+	{0xBD81, 0xBD9B, breakH3},
+	{0xBD9C, 0xBD9C, breakH2},
+	{0xBD9D, 0xBDB7, breakH3},
+	{0xBDB8, 0xBDB8, breakH2},
+	{0xBDB9, 0xBDD3, breakH3},
+	{0xBDD4, 0xBDD4, breakH2},
+	{0xBDD5, 0xBDEF, breakH3},
+	{0xBDF0, 0xBDF0, breakH2},
+	{0xBDF1, 0xBE0B, breakH3},
+	{0xBE0C, 0xBE0C, breakH2},
+	{0xBE0D, 0xBE27, breakH3},
+	{0xBE28, 0xBE28, breakH2},
+	{0xBE29, 0xBE43, breakH3},
+	{0xBE44, 0xBE44, breakH2},
+	{0xBE45, 0xBE5F, breakH3},
+	{0xBE60, 0xBE60, breakH2},
+	{0xBE61, 0xBE7B, breakH3},
+	{0xBE7C, 0xBE7C, breakH2},
+	{0xBE7D, 0xBE97, breakH3},
+	{0xBE98, 0xBE98, breakH2},
+	{0xBE99, 0xBEB3, breakH3},
+	{0xBEB4, 0xBEB4, breakH2},
+	{0xBEB5, 0xBECF, breakH3},
+	{0xBED0, 0xBED0, breakH2},
+	{0xBED1, 0xBEEB, breakH3},
+	{0xBEEC, 0xBEEC, breakH2},
+	{0xBEED, 0xBF07, breakH3},
+	{0xBF08, 0xBF08, breakH2},
+	{0xBF09, 0xBF23, breakH3},
+	{0xBF24, 0xBF24, breakH2},
+	{0xBF25, 0xBF3F, breakH3},
+	{0xBF40, 0xBF40, breakH2},
+	{0xBF41, 0xBF5B, breakH3},
+	{0xBF5C, 0xBF5C, breakH2},
+	{0xBF5D, 0xBF77, breakH3},
+	{0xBF78, 0xBF78, breakH2},
+	{0xBF79, 0xBF93, breakH3},
+	{0xBF94, 0xBF94, breakH2},
+	{0xBF95, 0xBFAF, breakH3},
+	{0xBFB0, 0xBFB0, breakH2},
+	{0xBFB1, 0xBFCB, breakH3},
+	{0xBFCC, 0xBFCC, breakH2},
+	{0xBFCD, 0xBFE7, breakH3},
+	{0xBFE8, 0xBFE8, breakH2},
+	{0xBFE9, 0xC003, breakH3},
+	{0xC004, 0xC004, breakH2},
+	{0xC005, 0xC01F, breakH3},
+	{0xC020, 0xC020, breakH2},
+	{0xC021, 0xC03B, breakH3},
+	{0xC03C, 0xC03C, breakH2},
+	{0xC03D, 0xC057, breakH3},
+	{0xC058, 0xC058, breakH2},
+	{0xC059, 0xC073, breakH3},
+	{0xC074, 0xC074, breakH2},
+	{0xC075, 0xC08F, breakH3},
+	{0xC090, 0xC090, breakH2},
+	{0xC091, 0xC0AB, breakH3},
+	{0xC0AC, 0xC0AC, breakH2},
+	{0xC0AD, 0xC0C7, breakH3},
+	{0xC0C8, 0xC0C8, breakH2},
+	{0xC0C9, 0xC0E3, breakH3},
+	{0xC0E4, 0xC0E4, breakH2},
+	{0xC0E5, 0xC0FF, breakH3},
+	{0xC100, 0xC100, breakH2},
+	{0xC101, 0xC11B, breakH3},
+	{0xC11C, 0xC11C, breakH2},
+	{0xC11D, 0xC137, breakH3},
+	{0xC138, 0xC138, breakH2},
+	{0xC139, 0xC153, breakH3},
+	{0xC154, 0xC154, breakH2},
+	{0xC155, 0xC16F, breakH3},
+	{0xC170, 0xC170, breakH2},
+	{0xC171, 0xC18B, breakH3},
+	{0xC18C, 0xC18C, breakH2},
+	{0xC18D, 0xC1A7, breakH3},
+	{0xC1A8, 0xC1A8, breakH2},
+	{0xC1A9, 0xC1C3, breakH3},
+	{0xC1C4, 0xC1C4, breakH2},
+	{0xC1C5, 0xC1DF, breakH3},
+	{0xC1E0, 0xC1E0, breakH2},
+	{0xC1E1, 0xC1FB, breakH3},
+	{0xC1FC, 0xC1FC, breakH2},
+	{0xC1FD, 0xC217, breakH3},
+	{0xC218, 0xC218, breakH2},
+	{0xC219, 0xC233, breakH3},
+	{0xC234, 0xC234, breakH2},
+	{0xC235, 0xC24F, breakH3},
+	{0xC250, 0xC250, breakH2},
+	{0xC251, 0xC26B, breakH3},
+	{0xC26C, 0xC26C, breakH2},
+	{0xC26D, 0xC287, breakH3},
+	{0xC288, 0xC288, breakH2},
+	{0xC289, 0xC2A3, breakH3},
+	{0xC2A4, 0xC2A4, breakH2},
+	{0xC2A5, 0xC2BF, breakH3},
+	{0xC2C0, 0xC2C0, breakH2},
+	{0xC2C1, 0xC2DB, breakH3},
+	{0xC2DC, 0xC2DC, breakH2},
+	{0xC2DD, 0xC2F7, breakH3},
+	{0xC2F8, 0xC2F8, breakH2},
+	{0xC2F9, 0xC313, breakH3},
+	{0xC314, 0xC314, breakH2},
+	{0xC315, 0xC32F, breakH3},
+	{0xC330, 0xC330, breakH2},
+	{0xC331, 0xC34B, breakH3},
+	{0xC34C, 0xC34C, breakH2},
+	{0xC34D, 0xC367, breakH3},
+	{0xC368, 0xC368, breakH2},
+	{0xC369, 0xC383, breakH3},
+	{0xC384, 0xC384, breakH2},
+	{0xC385, 0xC39F, breakH3},
+	{0xC3A0, 0xC3A0, breakH2},
+	{0xC3A1, 0xC3BB, breakH3},
+	{0xC3BC, 0xC3BC, breakH2},
+	{0xC3BD, 0xC3D7, breakH3},
+	{0xC3D8, 0xC3D8, breakH2},
+	{0xC3D9, 0xC3F3, breakH3},
+	{0xC3F4, 0xC3F4, breakH2},
+	{0xC3F5, 0xC40F, breakH3},
+	{0xC410, 0xC410, breakH2},
+	{0xC411, 0xC42B, breakH3},
+	{0xC42C, 0xC42C, breakH2},
+	{0xC42D, 0xC447, breakH3},
+	{0xC448, 0xC448, breakH2},
+	{0xC449, 0xC463, breakH3},
+	{0xC464, 0xC464, breakH2},
+	{0xC465, 0xC47F, breakH3},
+	{0xC480, 0xC480, breakH2},
+	{0xC481, 0xC49B, breakH3},
+	{0xC49C, 0xC49C, breakH2},
+	{0xC49D, 0xC4B7, breakH3},
+	{0xC4B8, 0xC4B8, breakH2},
+	{0xC4B9, 0xC4D3, breakH3},
+	{0xC4D4, 0xC4D4, breakH2},
+	{0xC4D5, 0xC4EF, breakH3},
+	{0xC4F0, 0xC4F0, breakH2},
+	{0xC4F1, 0xC50B, breakH3},
+	{0xC50C, 0xC50C, breakH2},
+	{0xC50D, 0xC527, breakH3},
+	{0xC528, 0xC528, breakH2},
+	{0xC529, 0xC543, breakH3},
+	{0xC544, 0xC544, breakH2},
+	{0xC545, 0xC55F, breakH3},
+	{0xC560, 0xC560, breakH2},
+	{0xC561, 0xC57B, breakH3},
+	{0xC57C, 0xC57C, breakH2},
+	{0xC57D, 0xC597, breakH3},
+	{0xC598, 0xC598, breakH2},
+	{0xC599, 0xC5B3, breakH3},
+	{0xC5B4, 0xC5B4, breakH2},
+	{0xC5B5, 0xC5CF, breakH3},
+	{0xC5D0, 0xC5D0, breakH2},
+	{0xC5D1, 0xC5EB, breakH3},
+	{0xC5EC, 0xC5EC, breakH2},
+	{0xC5ED, 0xC607, breakH3},
+	{0xC608, 0xC608, breakH2},
+	{0xC609, 0xC623, breakH3},
+	{0xC624, 0xC624, breakH2},
+	{0xC625, 0xC63F, breakH3},
+	{0xC640, 0xC640, breakH2},
+	{0xC641, 0xC65B, breakH3},
+	{0xC65C, 0xC65C, breakH2},
+	{0xC65D, 0xC677, breakH3},
+	{0xC678, 0xC678, breakH2},
+	{0xC679, 0xC693, breakH3},
+	{0xC694, 0xC694, breakH2},
+	{0xC695, 0xC6AF, breakH3},
+	{0xC6B0, 0xC6B0, breakH2},
+	{0xC6B1, 0xC6CB, breakH3},
+	{0xC6CC, 0xC6CC, breakH2},
+	{0xC6CD, 0xC6E7, breakH3},
+	{0xC6E8, 0xC6E8, breakH2},
+	{0xC6E9, 0xC703, breakH3},
+	{0xC704, 0xC704, breakH2},
+	{0xC705, 0xC71F, breakH3},
+	{0xC720, 0xC720, breakH2},
+	{0xC721, 0xC73B, breakH3},
+	{0xC73C, 0xC73C, breakH2},
+	{0xC73D, 0xC757, breakH3},
+	{0xC758, 0xC758, breakH2},
+	{0xC759, 0xC773, breakH3},
+	{0xC774, 0xC774, breakH2},
+	{0xC775, 0xC78F, breakH3},
+	{0xC790, 0xC790, breakH2},
+	{0xC791, 0xC7AB, breakH3},
+	{0xC7AC, 0xC7AC, breakH2},
+	{0xC7AD, 0xC7C7, breakH3},
+	{0xC7C8, 0xC7C8, breakH2},
+	{0xC7C9, 0xC7E3, breakH3},
+	{0xC7E4, 0xC7E4, breakH2},
+	{0xC7E5, 0xC7FF, breakH3},
+	{0xC800, 0xC800, breakH2},
+	{0xC801, 0xC81B, breakH3},
+	{0xC81C, 0xC81C, breakH2},
+	{0xC81D, 0xC837, breakH3},
+	{0xC838, 0xC838, breakH2},
+	{0xC839, 0xC853, breakH3},
+	{0xC854, 0xC854, breakH2},
+	{0xC855, 0xC86F, breakH3},
+	{0xC870, 0xC870, breakH2},
+	{0xC871, 0xC88B, breakH3},
+	{0xC88C, 0xC88C, breakH2},
+	{0xC88D, 0xC8A7, breakH3},
+	{0xC8A8, 0xC8A8, breakH2},
+	{0xC8A9, 0xC8C3, breakH3},
+	{0xC8C4, 0xC8C4, breakH2},
+	{0xC8C5, 0xC8DF, breakH3},
+	{0xC8E0, 0xC8E0, breakH2},
+	{0xC8E1, 0xC8FB, breakH3},
+	{0xC8FC, 0xC8FC, breakH2},
+	{0xC8FD, 0xC917, breakH3},
+	{0xC918, 0xC918, breakH2},
+	{0xC919, 0xC933, breakH3},
+	{0xC934, 0xC934, breakH2},
+	{0xC935, 0xC94F, breakH3},
+	{0xC950, 0xC950, breakH2},
+	{0xC951, 0xC96B, breakH3},
+	{0xC96C, 0xC96C, breakH2},
+	{0xC96D, 0xC987, breakH3},
+	{0xC988, 0xC988, breakH2},
+	{0xC989, 0xC9A3, breakH3},
+	{0xC9A4, 0xC9A4, breakH2},
+	{0xC9A5, 0xC9BF, breakH3},
+	{0xC9C0, 0xC9C0, breakH2},
+	{0xC9C1, 0xC9DB, breakH3},
+	{0xC9DC, 0xC9DC, breakH2},
+	{0xC9DD, 0xC9F7, breakH3},
+	{0xC9F8, 0xC9F8, breakH2},
+	{0xC9F9, 0xCA13, breakH3},
+	{0xCA14, 0xCA14, breakH2},
+	{0xCA15, 0xCA2F, breakH3},
+	{0xCA30, 0xCA30, breakH2},
+	{0xCA31, 0xCA4B, breakH3},
+	{0xCA4C, 0xCA4C, breakH2},
+	{0xCA4D, 0xCA67, breakH3},
+	{0xCA68, 0xCA68, breakH2},
+	{0xCA69, 0xCA83, breakH3},
+	{0xCA84, 0xCA84, breakH2},
+	{0xCA85, 0xCA9F, breakH3},
+	{0xCAA0, 0xCAA0, breakH2},
+	{0xCAA1, 0xCABB, breakH3},
+	{0xCABC, 0xCABC, breakH2},
+	{0xCABD, 0xCAD7, breakH3},
+	{0xCAD8, 0xCAD8, breakH2},
+	{0xCAD9, 0xCAF3, breakH3},
+	{0xCAF4, 0xCAF4, breakH2},
+	{0xCAF5, 0xCB0F, breakH3},
+	{0xCB10, 0xCB10, breakH2},
+	{0xCB11, 0xCB2B, breakH3},
+	{0xCB2C, 0xCB2C, breakH2},
+	{0xCB2D, 0xCB47, breakH3},
+	{0xCB48, 0xCB48, breakH2},
+	{0xCB49, 0xCB63, breakH3},
+	{0xCB64, 0xCB64, breakH2},
+	{0xCB65, 0xCB7F, breakH3},
+	{0xCB80, 0xCB80, breakH2},
+	{0xCB81, 0xCB9B, breakH3},
+	{0xCB9C, 0xCB9C, breakH2},
+	{0xCB9D, 0xCBB7, breakH3},
+	{0xCBB8, 0xCBB8, breakH2},
+	{0xCBB9, 0xCBD3, breakH3},
+	{0xCBD4, 0xCBD4, breakH2},
+	{0xCBD5, 0xCBEF, breakH3},
+	{0xCBF0, 0xCBF0, breakH2},
+	{0xCBF1, 0xCC0B, breakH3},
+	{0xCC0C, 0xCC0C, breakH2},
+	{0xCC0D, 0xCC27, breakH3},
+	{0xCC28, 0xCC28, breakH2},
+	{0xCC29, 0xCC43, breakH3},
+	{0xCC44, 0xCC44, breakH2},
+	{0xCC45, 0xCC5F, breakH3},
+	{0xCC60, 0xCC60, breakH2},
+	{0xCC61, 0xCC7B, breakH3},
+	{0xCC7C, 0xCC7C, breakH2},
+	{0xCC7D, 0xCC97, breakH3},
+	{0xCC98, 0xCC98, breakH2},
+	{0xCC99, 0xCCB3, breakH3},
+	{0xCCB4, 0xCCB4, breakH2},
+	{0xCCB5, 0xCCCF, breakH3},
+	{0xCCD0, 0xCCD0, breakH2},
+	{0xCCD1, 0xCCEB, breakH3},
+	{0xCCEC, 0xCCEC, breakH2},
+	{0xCCED, 0xCD07, breakH3},
+	{0xCD08, 0xCD08, breakH2},
+	{0xCD09, 0xCD23, breakH3},
+	{0xCD24, 0xCD24, breakH2},
+	{0xCD25, 0xCD3F, breakH3},
+	{0xCD40, 0xCD40, breakH2},
+	{0xCD41, 0xCD5B, breakH3},
+	{0xCD5C, 0xCD5C, breakH2},
+	{0xCD5D, 0xCD77, breakH3},
+	{0xCD78, 0xCD78, breakH2},
+	{0xCD79, 0xCD93, breakH3},
+	{0xCD94, 0xCD94, breakH2},
+	{0xCD95, 0xCDAF, breakH3},
+	{0xCDB0, 0xCDB0, breakH2},
+	{0xCDB1, 0xCDCB, breakH3},
+	{0xCDCC, 0xCDCC, breakH2},
+	{0xCDCD, 0xCDE7, breakH3},
+	{0xCDE8, 0xCDE8, breakH2},
+	{0xCDE9, 0xCE03, breakH3},
+	{0xCE04, 0xCE04, breakH2},
+	{0xCE05, 0xCE1F, breakH3},
+	{0xCE20, 0xCE20, breakH2},
+	{0xCE21, 0xCE3B, breakH3},
+	{0xCE3C, 0xCE3C, breakH2},
+	{0xCE3D, 0xCE57, breakH3},
+	{0xCE58, 0xCE58, breakH2},
+	{0xCE59, 0xCE73, breakH3},
+	{0xCE74, 0xCE74, breakH2},
+	{0xCE75, 0xCE8F, breakH3},
+	{0xCE90, 0xCE90, breakH2},
+	{0xCE91, 0xCEAB, breakH3},
+	{0xCEAC, 0xCEAC, breakH2},
+	{0xCEAD, 0xCEC7, breakH3},
+	{0xCEC8, 0xCEC8, breakH2},
+	{0xCEC9, 0xCEE3, breakH3},
+	{0xCEE4, 0xCEE4, breakH2},
+	{0xCEE5, 0xCEFF, breakH3},
+	{0xCF00, 0xCF00, breakH2},
+	{0xCF01, 0xCF1B, breakH3},
+	{0xCF1C, 0xCF1C, breakH2},
+	{0xCF1D, 0xCF37, breakH3},
+	{0xCF38, 0xCF38, breakH2},
+	{0xCF39, 0xCF53, breakH3},
+	{0xCF54, 0xCF54, breakH2},
+	{0xCF55, 0xCF6F, breakH3},
+	{0xCF70, 0xCF70, breakH2},
+	{0xCF71, 0xCF8B, breakH3},
+	{0xCF8C, 0xCF8C, breakH2},
+	{0xCF8D, 0xCFA7, breakH3},
+	{0xCFA8, 0xCFA8, breakH2},
+	{0xCFA9, 0xCFC3, breakH3},
+	{0xCFC4, 0xCFC4, breakH2},
+	{0xCFC5, 0xCFDF, breakH3},
+	{0xCFE0, 0xCFE0, breakH2},
+	{0xCFE1, 0xCFFB, breakH3},
+	{0xCFFC, 0xCFFC, breakH2},
+	{0xCFFD, 0xD017, breakH3},
+	{0xD018, 0xD018, breakH2},
+	{0xD019, 0xD033, breakH3},
+	{0xD034, 0xD034, breakH2},
+	{0xD035, 0xD04F, breakH3},
+	{0xD050, 0xD050, breakH2},
+	{0xD051, 0xD06B, breakH3},
+	{0xD06C, 0xD06C, breakH2},
+	{0xD06D, 0xD087, breakH3},
+	{0xD088, 0xD088, breakH2},
+	{0xD089, 0xD0A3, breakH3},
+	{0xD0A4, 0xD0A4, breakH2},
+	{0xD0A5, 0xD0BF, breakH3},
+	{0xD0C0, 0xD0C0, breakH2},
+	{0xD0C1, 0xD0DB, breakH3},
+	{0xD0DC, 0xD0DC, breakH2},
+	{0xD0DD, 0xD0F7, breakH3},
+	{0xD0F8, 0xD0F8, breakH2},
+	{0xD0F9, 0xD113, breakH3},
+	{0xD114, 0xD114, breakH2},
+	{0xD115, 0xD12F, breakH3},
+	{0xD130, 0xD130, breakH2},
+	{0xD131, 0xD14B, breakH3},
+	{0xD14C, 0xD14C, breakH2},
+	{0xD14D, 0xD167, breakH3},
+	{0xD168, 0xD168, breakH2},
+	{0xD169, 0xD183, breakH3},
+	{0xD184, 0xD184, breakH2},
+	{0xD185, 0xD19F, breakH3},
+	{0xD1A0, 0xD1A0, breakH2},
+	{0xD1A1, 0xD1BB, breakH3},
+	{0xD1BC, 0xD1BC, breakH2},
+	{0xD1BD, 0xD1D7, breakH3},
+	{0xD1D8, 0xD1D8, breakH2},
+	{0xD1D9, 0xD1F3, breakH3},
+	{0xD1F4, 0xD1F4, breakH2},
+	{0xD1F5, 0xD20F, breakH3},
+	{0xD210, 0xD210, breakH2},
+	{0xD211, 0xD22B, breakH3},
+	{0xD22C, 0xD22C, breakH2},
+	{0xD22D, 0xD247, breakH3},
+	{0xD248, 0xD248, breakH2},
+	{0xD249, 0xD263, breakH3},
+	{0xD264, 0xD264, breakH2},
+	{0xD265, 0xD27F, breakH3},
+	{0xD280, 0xD280, breakH2},
+	{0xD281, 0xD29B, breakH3},
+	{0xD29C, 0xD29C, breakH2},
+	{0xD29D, 0xD2B7, breakH3},
+	{0xD2B8, 0xD2B8, breakH2},
+	{0xD2B9, 0xD2D3, breakH3},
+	{0xD2D4, 0xD2D4, breakH2},
+	{0xD2D5, 0xD2EF, breakH3},
+	{0xD2F0, 0xD2F0, breakH2},
+	{0xD2F1, 0xD30B, breakH3},
+	{0xD30C, 0xD30C, breakH2},
+	{0xD30D, 0xD327, breakH3},
+	{0xD328, 0xD328, breakH2},
+	{0xD329, 0xD343, breakH3},
+	{0xD344, 0xD344, breakH2},
+	{0xD345, 0xD35F, breakH3},
+	{0xD360, 0xD360, breakH2},
+	{0xD361, 0xD37B, breakH3},
+	{0xD37C, 0xD37C, breakH2},
+	{0xD37D, 0xD397, breakH3},
+	{0xD398, 0xD398, breakH2},
+	{0xD399, 0xD3B3, breakH3},
+	{0xD3B4, 0xD3B4, breakH2},
+	{0xD3B5, 0xD3CF, breakH3},
+	{0xD3D0, 0xD3D0, breakH2},
+	{0xD3D1, 0xD3EB, breakH3},
+	{0xD3EC, 0xD3EC, breakH2},
+	{0xD3ED, 0xD407, breakH3},
+	{0xD408, 0xD408, breakH2},
+	{0xD409, 0xD423, breakH3},
+	{0xD424, 0xD424, breakH2},
+	{0xD425, 0xD43F, breakH3},
+	{0xD440, 0xD440, breakH2},
+	{0xD441, 0xD45B, breakH3},
+	{0xD45C, 0xD45C, breakH2},
+	{0xD45D, 0xD477, breakH3},
+	{0xD478, 0xD478, breakH2},
+	{0xD479, 0xD493, breakH3},
+	{0xD494, 0xD494, breakH2},
+	{0xD495, 0xD4AF, breakH3},
+	{0xD4B0, 0xD4B0, breakH2},
+	{0xD4B1, 0xD4CB, breakH3},
+	{0xD4CC, 0xD4CC, breakH2},
+	{0xD4CD, 0xD4E7, breakH3},
+	{0xD4E8, 0xD4E8, breakH2},
+	{0xD4E9, 0xD503, breakH3},
+	{0xD504, 0xD504, breakH2},
+	{0xD505, 0xD51F, breakH3},
+	{0xD520, 0xD520, breakH2},
+	{0xD521, 0xD53B, breakH3},
+	{0xD53C, 0xD53C, breakH2},
+	{0xD53D, 0xD557, breakH3},
+	{0xD558, 0xD558, breakH2},
+	{0xD559, 0xD573, breakH3},
+	{0xD574, 0xD574, breakH2},
+	{0xD575, 0xD58F, breakH3},
+	{0xD590, 0xD590, breakH2},
+	{0xD591, 0xD5AB, breakH3},
+	{0xD5AC, 0xD5AC, breakH2},
+	{0xD5AD, 0xD5C7, breakH3},
+	{0xD5C8, 0xD5C8, breakH2},
+	{0xD5C9, 0xD5E3, breakH3},
+	{0xD5E4, 0xD5E4, breakH2},
+	{0xD5E5, 0xD5FF, breakH3},
+	{0xD600, 0xD600, breakH2},
+	{0xD601, 0xD61B, breakH3},
+	{0xD61C, 0xD61C, breakH2},
+	{0xD61D, 0xD637, breakH3},
+	{0xD638, 0xD638, breakH2},
+	{0xD639, 0xD653, breakH3},
+	{0xD654, 0xD654, breakH2},
+	{0xD655, 0xD66F, breakH3},
+	{0xD670, 0xD670, breakH2},
+	{0xD671, 0xD68B, breakH3},
+	{0xD68C, 0xD68C, breakH2},
+	{0xD68D, 0xD6A7, breakH3},
+	{0xD6A8, 0xD6A8, breakH2},
+	{0xD6A9, 0xD6C3, breakH3},
+	{0xD6C4, 0xD6C4, breakH2},
+	{0xD6C5, 0xD6DF, breakH3},
+	{0xD6E0, 0xD6E0, breakH2},
+	{0xD6E1, 0xD6FB, breakH3},
+	{0xD6FC, 0xD6FC, breakH2},
+	{0xD6FD, 0xD717, breakH3},
+	{0xD718, 0xD718, breakH2},
+	{0xD719, 0xD733, breakH3},
+	{0xD734, 0xD734, breakH2},
+	{0xD735, 0xD74F, breakH3},
+	{0xD750, 0xD750, breakH2},
+	{0xD751, 0xD76B, breakH3},
+	{0xD76C, 0xD76C, breakH2},
+	{0xD76D, 0xD787, breakH3},
+	{0xD788, 0xD788, breakH2},
+	{0xD789, 0xD7A3, breakH3},
+	{0xD7B0, 0xD7C6, breakJV},
+	{0xD7CB, 0xD7FB, breakJT},
+	{0xD800, 0xDFFF, breakSG},
+	{0xE000, 0xF8FF, breakXX},
+	{0xF900, 0xFAFF, breakID},
+	{0xFB00, 0xFB06, breakAL},
+	{0xFB13, 0xFB17, breakAL},
+	{0xFB1D, 0xFB1D, breakHL},
+	{0xFB1E, 0xFB1E, breakCM},
+	{0xFB1F, 0xFB28, breakHL},
+	{0xFB29, 0xFB29, breakAL},
+	{0xFB2A, 0xFB36, breakHL},
+	{0xFB38, 0xFB3C, breakHL},
+	{0xFB3E, 0xFB3E, breakHL},
+	{0xFB40, 0xFB41, breakHL},
+	{0xFB43, 0xFB44, breakHL},
+	{0xFB46, 0xFB4F, breakHL},
+	{0xFB50, 0xFBC2, breakAL},
+	{0xFBD3, 0xFD3D, breakAL},
+	{0xFD3E, 0xFD3E, breakCL},
+	{0xFD3F, 0xFD3F, breakOP},
+	{0xFD40, 0xFD8F, breakAL},
+	{0xFD92, 0xFDC7, breakAL},
+	{0xFDCF, 0xFDCF, breakAL},
+	{0xFDF0, 0xFDFB, breakAL},
+	{0xFDFC, 0xFDFC, breakPO},
+	{0xFDFD, 0xFDFF, breakAL},
+	{0xFE00, 0xFE0F, breakCM},
+	{0xFE10, 0xFE10, breakIS},
+	{0xFE11, 0xFE12, breakCL},
+	{0xFE13, 0xFE14, breakIS},
+	{0xFE15, 0xFE16, breakEX},
+	{0xFE17, 0xFE17, breakOP},
+	{0xFE18, 0xFE18, breakCL},
+	{0xFE19, 0xFE19, breakIN},
+	{0xFE20, 0xFE2F, breakCM},
+	{0xFE30, 0xFE34, breakID},
+	{0xFE35, 0xFE35, breakOP},
+	{0xFE36, 0xFE36, breakCL},
+	{0xFE37, 0xFE37, breakOP},
+	{0xFE38, 0xFE38, breakCL},
+	{0xFE39, 0xFE39, breakOP},
+	{0xFE3A, 0xFE3A, breakCL},
+	{0xFE3B, 0xFE3B, breakOP},
+	{0xFE3C, 0xFE3C, breakCL},
+	{0xFE3D, 0xFE3D, breakOP},
+	{0xFE3E, 0xFE3E, breakCL},
+	{0xFE3F, 0xFE3F, breakOP},
+	{0xFE40, 0xFE40, breakCL},
+	{0xFE41, 0xFE41, breakOP},
+	{0xFE42, 0xFE42, breakCL},
+	{0xFE43, 0xFE43, breakOP},
+	{0xFE44, 0xFE44, breakCL},
+	{0xFE45, 0xFE46, breakID},
+	{0xFE47, 0xFE47, breakOP},
+	{0xFE48, 0xFE48, breakCL},
+	{0xFE49, 0xFE4F, breakID},
+	{0xFE50, 0xFE50, breakCL},
+	{0xFE51, 0xFE51, breakID},
+	{0xFE52, 0xFE52, breakCL},
+	{0xFE54, 0xFE55, breakNS},
+	{0xFE56, 0xFE57, breakEX},
+	{0xFE58, 0xFE58, breakID},
+	{0xFE59, 0xFE59, breakOP},
+	{0xFE5A, 0xFE5A, breakCL},
+	{0xFE5B, 0xFE5B, breakOP},
+	{0xFE5C, 0xFE5C, breakCL},
+	{0xFE5D, 0xFE5D, breakOP},
+	{0xFE5E, 0xFE5E, breakCL},
+	{0xFE5F, 0xFE66, breakID},
+	{0xFE68, 0xFE68, breakID},
+	{0xFE69, 0xFE69, breakPR},
+	{0xFE6A, 0xFE6A, breakPO},
+	{0xFE6B, 0xFE6B, breakID},
+	{0xFE70, 0xFE74, breakAL},
+	{0xFE76, 0xFEFC, breakAL},
+	{0xFEFF, 0xFEFF, breakWJ},
+	{0xFF01, 0xFF01, breakEX},
+	{0xFF02, 0xFF03, breakID},
+	{0xFF04, 0xFF04, breakPR},
+	{0xFF05, 0xFF05, breakPO},
+	{0xFF06, 0xFF07, breakID},
+	{0xFF08, 0xFF08, breakOP},
+	{0xFF09, 0xFF09, breakCL},
+	{0xFF0A, 0xFF0B, breakID},
+	{0xFF0C, 0xFF0C, breakCL},
+	{0xFF0D, 0xFF0D, breakID},
+	{0xFF0E, 0xFF0E, breakCL},
+	{0xFF0F, 0xFF19, breakID},
+	{0xFF1A, 0xFF1B, breakNS},
+	{0xFF1C, 0xFF1E, breakID},
+	{0xFF1F, 0xFF1F, breakEX},
+	{0xFF20, 0xFF3A, breakID},
+	{0xFF3B, 0xFF3B, breakOP},
+	{0xFF3C, 0xFF3C, breakID},
+	{0xFF3D, 0xFF3D, breakCL},
+	{0xFF3E, 0xFF5A, breakID},
+	{0xFF5B, 0xFF5B, breakOP},
+	{0xFF5C, 0xFF5C, breakID},
+	{0xFF5D, 0xFF5D, breakCL},
+	{0xFF5E, 0xFF5E, breakID},
+	{0xFF5F, 0xFF5F, breakOP},
+	{0xFF60, 0xFF61, breakCL},
+	{0xFF62, 0xFF62, breakOP},
+	{0xFF63, 0xFF64, breakCL},
+	{0xFF65, 0xFF65, breakNS},
+	{0xFF66, 0xFF66, breakID},
+	{0xFF67, 0xFF70, breakCJ},
+	{0xFF71, 0xFF9D, breakID},
+	{0xFF9E, 0xFF9F, breakNS},
+	{0xFFA0, 0xFFBE, breakID},
+	{0xFFC2, 0xFFC7, breakID},
+	{0xFFCA, 0xFFCF, breakID},
+	{0xFFD2, 0xFFD7, breakID},
+	{0xFFDA, 0xFFDC, breakID},
+	{0xFFE0, 0xFFE0, breakPO},
+	{0xFFE1, 0xFFE1, breakPR},
+	{0xFFE2, 0xFFE4, breakID},
+	{0xFFE5, 0xFFE6, breakPR},
+	{0xFFE8, 0xFFEE, breakAL},
+	{0xFFF9, 0xFFFB, breakCM},
+	{0xFFFC, 0xFFFC, breakCB},
+	{0xFFFD, 0xFFFD, breakAI},
+	{0x10000, 0x1000B, breakAL},
+	{0x1000D, 0x10026, breakAL},
+	{0x10028, 0x1003A, breakAL},
+	{0x1003C, 0x1003D, breakAL},
+	{0x1003F, 0x1004D, breakAL},
+	{0x10050, 0x1005D, breakAL},
+	{0x10080, 0x100FA, breakAL},
+	{0x10100, 0x10102, breakBA},
+	{0x10107, 0x10133, breakAL},
+	{0x10137, 0x1018E, breakAL},
+	{0x10190, 0x1019C, breakAL},
+	{0x101A0, 0x101A0, breakAL},
+	{0x101D0, 0x101FC, breakAL},
+	{0x101FD, 0x101FD, breakCM},
+	{0x10280, 0x1029C, breakAL},
+	{0x102A0, 0x102D0, breakAL},
+	{0x102E0, 0x102E0, breakCM},
+	{0x102E1, 0x102FB, breakAL},
+	{0x10300, 0x10323, breakAL},
+	{0x1032D, 0x1034A, breakAL},
+	{0x10350, 0x10375, breakAL},
+	{0x10376, 0x1037A, breakCM},
+	{0x10380, 0x1039D, breakAL},
+	{0x1039F, 0x1039F, breakBA},
+	{0x103A0, 0x103C3, breakAL},
+	{0x103C8, 0x103CF, breakAL},
+	{0x103D0, 0x103D0, breakBA},
+	{0x103D1, 0x103D5, breakAL},
+	{0x10400, 0x1049D, breakAL},
+	{0x104A0, 0x104A9, breakNU},
+	{0x104B0, 0x104D3, breakAL},
+	{0x104D8, 0x104FB, breakAL},
+	{0x10500, 0x10527, breakAL},
+	{0x10530, 0x10563, breakAL},
+	{0x1056F, 0x1057A, breakAL},
+	{0x1057C, 0x1058A, breakAL},
+	{0x1058C, 0x10592, breakAL},
+	{0x10594, 0x10595, breakAL},
+	{0x10597, 0x105A1, breakAL},
+	{0x105A3, 0x105B1, breakAL},
+	{0x105B3, 0x105B9, breakAL},
+	{0x105BB, 0x105BC, breakAL},
+	{0x10600, 0x10736, breakAL},
+	{0x10740, 0x10755, breakAL},
+	{0x10760, 0x10767, breakAL},
+	{0x10780, 0x10785, breakAL},
+	{0x10787, 0x107B0, breakAL},
+	{0x107B2, 0x107BA, breakAL},
+	{0x10800, 0x10805, breakAL},
+	{0x10808, 0x10808, breakAL},
+	{0x1080A, 0x10835, breakAL},
+	{0x10837, 0x10838, breakAL},
+	{0x1083C, 0x1083C, breakAL},
+	{0x1083F, 0x10855, breakAL},
+	{0x10857, 0x10857, breakBA},
+	{0x10858, 0x1089E, breakAL},
+	{0x108A7, 0x108AF, breakAL},
+	{0x108E0, 0x108F2, breakAL},
+	{0x108F4, 0x108F5, breakAL},
+	{0x108FB, 0x1091B, breakAL},
+	{0x1091F, 0x1091F, breakBA},
+	{0x10920, 0x10939, breakAL},
+	{0x1093F, 0x1093F, breakAL},
+	{0x10980, 0x109B7, breakAL},
+	{0x109BC, 0x109CF, breakAL},
+	{0x109D2, 0x10A00, breakAL},
+	{0x10A01, 0x10A03, breakCM},
+	{0x10A05, 0x10A06, breakCM},
+	{0x10A0C, 0x10A0F, breakCM},
+	{0x10A10, 0x10A13, breakAL},
+	{0x10A15, 0x10A17, breakAL},
+	{0x10A19, 0x10A35, breakAL},
+	{0x10A38, 0x10A3A, breakCM},
+	{0x10A3F, 0x10A3F, breakCM},
+	{0x10A40, 0x10A48, breakAL},
+	{0x10A50, 0x10A57, breakBA},
+	{0x10A58, 0x10A58, breakAL},
+	{0x10A60, 0x10A9F, breakAL},
+	{0x10AC0, 0x10AE4, breakAL},
+	{0x10AE5, 0x10AE6, breakCM},
+	{0x10AEB, 0x10AEF, breakAL},
+	{0x10AF0, 0x10AF5, breakBA},
+	{0x10AF6, 0x10AF6, breakIN},
+	{0x10B00, 0x10B35, breakAL},
+	{0x10B39, 0x10B3F, breakBA},
+	{0x10B40, 0x10B55, breakAL},
+	{0x10B58, 0x10B72, breakAL},
+	{0x10B78, 0x10B91, breakAL},
+	{0x10B99, 0x10B9C, breakAL},
+	{0x10BA9, 0x10BAF, breakAL},
+	{0x10C00, 0x10C48, breakAL},
+	{0x10C80, 0x10CB2, breakAL},
+	{0x10CC0, 0x10CF2, breakAL},
+	{0x10CFA, 0x10D23, breakAL},
+	{0x10D24, 0x10D27, breakCM},
+	{0x10D30, 0x10D39, breakNU},
+	{0x10E60, 0x10E7E, breakAL},
+	{0x10E80, 0x10EA9, breakAL},
+	{0x10EAB, 0x10EAC, breakCM},
+	{0x10EAD, 0x10EAD, breakBA},
+	{0x10EB0, 0x10EB1, breakAL},
+	{0x10EFD, 0x10EFF, breakCM},
+	{0x10F00, 0x10F27, breakAL},
+	{0x10F30, 0x10F45, breakAL},
+	{0x10F46, 0x10F50, breakCM},
+	{0x10F51, 0x10F59, breakAL},
+	{0x10F70, 0x10F81, breakAL},
+	{0x10F82, 0x10F85, breakCM},
+	{0x10F86, 0x10F89, breakAL},
+	{0x10FB0, 0x10FCB, breakAL},
+	{0x10FE0, 0x10FF6, breakAL},
+	{0x11000, 0x11002, breakCM},
+	{0x11003, 0x11037, breakAL},
+	{0x11038, 0x11046, breakCM},
+	{0x11047, 0x11048, breakBA},
+	{0x11049, 0x1104D, breakAL},
+	{0x11052, 0x11065, breakAL},
+	{0x11066, 0x1106F, breakNU},
+	{0x11070, 0x11070, breakCM},
+	{0x11071, 0x11072, breakAL},
+	{0x11073, 0x11074, breakCM},
+	{0x11075, 0x11075, breakAL},
+	{0x1107F, 0x11082, breakCM},
+	{0x11083, 0x110AF, breakAL},
+	{0x110B0, 0x110BA, breakCM},
+	{0x110BB, 0x110BD, breakAL},
+	{0x110BE, 0x110C1, breakBA},
+	{0x110C2, 0x110C2, breakCM},
+	{0x110CD, 0x110CD, breakAL},
+	{0x110D0, 0x110E8, breakAL},
+	{0x110F0, 0x110F9, breakNU},
+	{0x11100, 0x11102, breakCM},
+	{0x11103, 0x11126, breakAL},
+	{0x11127, 0x11134, breakCM},
+	{0x11136, 0x1113F, breakNU},
+	{0x11140, 0x11143, breakBA},
+	{0x11144, 0x11144, breakAL},
+	{0x11145, 0x11146, breakCM},
+	{0x11147, 0x11147, breakAL},
+	{0x11150, 0x11172, breakAL},
+	{0x11173, 0x11173, breakCM},
+	{0x11174, 0x11174, breakAL},
+	{0x11175, 0x11175, breakBB},
+	{0x11176, 0x11176, breakAL},
+	{0x11180, 0x11182, breakCM},
+	{0x11183, 0x111B2, breakAL},
+	{0x111B3, 0x111C0, breakCM},
+	{0x111C1, 0x111C4, breakAL},
+	{0x111C5, 0x111C6, breakBA},
+	{0x111C7, 0x111C7, breakAL},
+	{0x111C8, 0x111C8, breakBA},
+	{0x111C9, 0x111CC, breakCM},
+	{0x111CD, 0x111CD, breakAL},
+	{0x111CE, 0x111CF, breakCM},
+	{0x111D0, 0x111D9, breakNU},
+	{0x111DA, 0x111DA, breakAL},
+	{0x111DB, 0x111DB, breakBB},
+	{0x111DC, 0x111DC, breakAL},
+	{0x111DD, 0x111DF, breakBA},
+	{0x111E1, 0x111F4, breakAL},
+	{0x11200, 0x11211, breakAL},
+	{0x11213, 0x1122B, breakAL},
+	{0x1122C, 0x11237, breakCM},
+	{0x11238, 0x11239, breakBA},
+	{0x1123A, 0x1123A, breakAL},
+	{0x1123B, 0x1123C, breakBA},
+	{0x1123D, 0x1123D, breakAL},
+	{0x1123E, 0x1123E, breakCM},
+	{0x1123F, 0x11240, breakAL},
+	{0x11241, 0x11241, breakCM},
+	{0x11280, 0x11286, breakAL},
+	{0x11288, 0x11288, breakAL},
+	{0x1128A, 0x1128D, breakAL},
+	{0x1128F, 0x1129D, breakAL},
+	{0x1129F, 0x112A8, breakAL},
+	{0x112A9, 0x112A9, breakBA},
+	{0x112B0, 0x112DE, breakAL},
+	{0x112DF, 0x112EA, breakCM},
+	{0x112F0, 0x112F9, breakNU},
+	{0x11300, 0x11303, breakCM},
+	{0x11305, 0x1130C, breakAL},
+	{0x1130F, 0x11310, breakAL},
+	{0x11313, 0x11328, breakAL},
+	{0x1132A, 0x11330, breakAL},
+	{0x11332, 0x11333, breakAL},
+	{0x11335, 0x11339, breakAL},
+	{0x1133B, 0x1133C, breakCM},
+	{0x1133D, 0x1133D, breakAL},
+	{0x1133E, 0x11344, breakCM},
+	{0x11347, 0x11348, breakCM},
+	{0x1134B, 0x1134D, breakCM},
+	{0x11350, 0x11350, breakAL},
+	{0x11357, 0x11357, breakCM},
+	{0x1135D, 0x11361, breakAL},
+	{0x11362, 0x11363, breakCM},
+	{0x11366, 0x1136C, breakCM},
+	{0x11370, 0x11374, breakCM},
+	{0x11400, 0x11434, breakAL},
+	{0x11435, 0x11446, breakCM},
+	{0x11447, 0x1144A, breakAL},
+	{0x1144B, 0x1144E, breakBA},
+	{0x1144F, 0x1144F, breakAL},
+	{0x11450, 0x11459, breakNU},
+	{0x1145A, 0x1145B, breakBA},
+	{0x1145D, 0x1145D, breakAL},
+	{0x1145E, 0x1145E, breakCM},
+	{0x1145F, 0x11461, breakAL},
+	{0x11480, 0x114AF, breakAL},
+	{0x114B0, 0x114C3, breakCM},
+	{0x114C4, 0x114C7, breakAL},
+	{0x114D0, 0x114D9, breakNU},
+	{0x11580, 0x115AE, breakAL},
+	{0x115AF, 0x115B5, breakCM},
+	{0x115B8, 0x115C0, breakCM},
+	{0x115C1, 0x115C1, breakBB},
+	{0x115C2, 0x115C3, breakBA},
+	{0x115C4, 0x115C5, breakEX},
+	{0x115C6, 0x115C8, breakAL},
+	{0x115C9, 0x115D7, breakBA},
+	{0x115D8, 0x115DB, breakAL},
+	{0x115DC, 0x115DD, breakCM},
+	{0x11600, 0x1162F, breakAL},
+	{0x11630, 0x11640, breakCM},
+	{0x11641, 0x11642, breakBA},
+	{0x11643, 0x11644, breakAL},
+	{0x11650, 0x11659, breakNU},
+	{0x11660, 0x1166C, breakBB},
+	{0x11680, 0x116AA, breakAL},
+	{0x116AB, 0x116B7, breakCM},
+	{0x116B8, 0x116B9, breakAL},
+	{0x116C0, 0x116C9, breakNU},
+	{0x11700, 0x1171A, breakSA},
+	{0x1171D, 0x1172B, breakSA},
+	{0x11730, 0x11739, breakNU},
+	{0x1173A, 0x1173B, breakSA},
+	{0x1173C, 0x1173E, breakBA},
+	{0x1173F, 0x11746, breakSA},
+	{0x11800, 0x1182B, breakAL},
+	{0x1182C, 0x1183A, breakCM},
+	{0x1183B, 0x1183B, breakAL},
+	{0x118A0, 0x118DF, breakAL},
+	{0x118E0, 0x118E9, breakNU},
+	{0x118EA, 0x118F2, breakAL},
+	{0x118FF, 0x11906, breakAL},
+	{0x11909, 0x11909, breakAL},
+	{0x1190C, 0x11913, breakAL},
+	{0x11915, 0x11916, breakAL},
+	{0x11918, 0x1192F, breakAL},
+	{0x11930, 0x11935, breakCM},
+	{0x11937, 0x11938, breakCM},
+	{0x1193B, 0x1193E, breakCM},
+	{0x1193F, 0x1193F, breakAL},
+	{0x11940, 0x11940, breakCM},
+	{0x11941, 0x11941, breakAL},
+	{0x11942, 0x11943, breakCM},
+	{0x11944, 0x11946, breakBA},
+	{0x11950, 0x11959, breakNU},
+	{0x119A0, 0x119A7, breakAL},
+	{0x119AA, 0x119D0, breakAL},
+	{0x119D1, 0x119D7, breakCM},
+	{0x119DA, 0x119E0, breakCM},
+	{0x119E1, 0x119E1, breakAL},
+	{0x119E2, 0x119E2, breakBB},
+	{0x119E3, 0x119E3, breakAL},
+	{0x119E4, 0x119E4, breakCM},
+	{0x11A00, 0x11A00, breakAL},
+	{0x11A01, 0x11A0A, breakCM},
+	{0x11A0B, 0x11A32, breakAL},
+	{0x11A33, 0x11A39, breakCM},
+	{0x11A3A, 0x11A3A, breakAL},
+	{0x11A3B, 0x11A3E, breakCM},
+	{0x11A3F, 0x11A3F, breakBB},
+	{0x11A40, 0x11A40, breakAL},
+	{0x11A41, 0x11A44, breakBA},
+	{0x11A45, 0x11A45, breakBB},
+	{0x11A46, 0x11A46, breakAL},
+	{0x11A47, 0x11A47, breakCM},
+	{0x11A50, 0x11A50, breakAL},
+	{0x11A51, 0x11A5B, breakCM},
+	{0x11A5C, 0x11A89, breakAL},
+	{0x11A8A, 0x11A99, breakCM},
+	{0x11A9A, 0x11A9C, breakBA},
+	{0x11A9D, 0x11A9D, breakAL},
+	{0x11A9E, 0x11AA0, breakBB},
+	{0x11AA1, 0x11AA2, breakBA},
+	{0x11AB0, 0x11AF8, breakAL},
+	{0x11B00, 0x11B09, breakBB},
+	{0x11C00, 0x11C08, breakAL},
+	{0x11C0A, 0x11C2E, breakAL},
+	{0x11C2F, 0x11C36, breakCM},
+	{0x11C38, 0x11C3F, breakCM},
+	{0x11C40, 0x11C40, breakAL},
+	{0x11C41, 0x11C45, breakBA},
+	{0x11C50, 0x11C59, breakNU},
+	{0x11C5A, 0x11C6C, breakAL},
+	{0x11C70, 0x11C70, breakBB},
+	{0x11C71, 0x11C71, breakEX},
+	{0x11C72, 0x11C8F, breakAL},
+	{0x11C92, 0x11CA7, breakCM},
+	{0x11CA9, 0x11CB6, breakCM},
+	{0x11D00, 0x11D06, breakAL},
+	{0x11D08, 0x11D09, breakAL},
+	{0x11D0B, 0x11D30, breakAL},
+	{0x11D31, 0x11D36, breakCM},
+	{0x11D3A, 0x11D3A, breakCM},
+	{0x11D3C, 0x11D3D, breakCM},
+	{0x11D3F, 0x11D45, breakCM},
+	{0x11D46, 0x11D46, breakAL},
+	{0x11D47, 0x11D47, breakCM},
+	{0x11D50, 0x11D59, breakNU},
+	{0x11D60, 0x11D65, breakAL},
+	{0x11D67, 0x11D68, breakAL},
+	{0x11D6A, 0x11D89, breakAL},
+	{0x11D8A, 0x11D8E, breakCM},
+	{0x11D90, 0x11D91, breakCM},
+	{0x11D93, 0x11D97, breakCM},
+	{0x11D98, 0x11D98, breakAL},
+	{0x11DA0, 0x11DA9, breakNU},
+	{0x11EE0, 0x11EF2, breakAL},
+	{0x11EF3, 0x11EF6, breakCM},
+	{0x11EF7, 0x11EF8, breakAL},
+	{0x11F00, 0x11F01, breakCM},
+	{0x11F02, 0x11F02, breakAL},
+	{0x11F03, 0x11F03, breakCM},
+	{0x11F04, 0x11F10, breakAL},
+	{0x11F12, 0x11F33, breakAL},
+	{0x11F34, 0x11F3A, breakCM},
+	{0x11F3E, 0x11F42, breakCM},
+	{0x11F43, 0x11F44, breakBA},
+	{0x11F45, 0x11F4F, breakID},
+	{0x11F50, 0x11F59, breakNU},
+	{0x11FB0, 0x11FB0, breakAL},
+	{0x11FC0, 0x11FDC, breakAL},
+	{0x11FDD, 0x11FE0, breakPO},
+	{0x11FE1, 0x11FF1, breakAL},
+	{0x11FFF, 0x11FFF, breakBA},
+	{0x12000, 0x12399, breakAL},
+	{0x12400, 0x1246E, breakAL},
+	{0x12470, 0x12474, breakBA},
+	{0x12480, 0x12543, breakAL},
+	{0x12F90, 0x12FF2, breakAL},
+	{0x13000, 0x13257, breakAL},
+	{0x13258, 0x1325A, breakOP},
+	{0x1325B, 0x1325D, breakCL},
+	{0x1325E, 0x13281, breakAL},
+	{0x13282, 0x13282, breakCL},
+	{0x13283, 0x13285, breakAL},
+	{0x13286, 0x13286, breakOP},
+	{0x13287, 0x13287, breakCL},
+	{0x13288, 0x13288, breakOP},
+	{0x13289, 0x13289, breakCL},
+	{0x1328A, 0x13378, breakAL},
+	{0x13379, 0x13379, breakOP},
+	{0x1337A, 0x1337B, breakCL},
+	{0x1337C, 0x1342F, breakAL},
+	{0x13430, 0x13436, breakGL},
+	{0x13437, 0x13437, breakOP},
+	{0x13438, 0x13438, breakCL},
+	{0x13439, 0x1343B, breakGL},
+	{0x1343C, 0x1343C, breakOP},
+	{0x1343D, 0x1343D, breakCL},
+	{0x1343E, 0x1343E, breakOP},
+	{0x1343F, 0x1343F, breakCL},
+	{0x13440, 0x13440, breakCM},
+	{0x13441, 0x13446, breakAL},
+	{0x13447, 0x13455, breakCM},
+	{0x14400, 0x145CD, breakAL},
+	{0x145CE, 0x145CE, breakOP},
+	{0x145CF, 0x145CF, breakCL},
+	{0x145D0, 0x14646, breakAL},
+	{0x16800, 0x16A38, breakAL},
+	{0x16A40, 0x16A5E, breakAL},
+	{0x16A60, 0x16A69, breakNU},
+	{0x16A6E, 0x16A6F, breakBA},
+	{0x16A70, 0x16ABE, breakAL},
+	{0x16AC0, 0x16AC9, breakNU},
+	{0x16AD0, 0x16AED, breakAL},
+	{0x16AF0, 0x16AF4, breakCM},
+	{0x16AF5, 0x16AF5, breakBA},
+	{0x16B00, 0x16B2F, breakAL},
+	{0x16B30, 0x16B36, breakCM},
+	{0x16B37, 0x16B39, breakBA},
+	{0x16B3A, 0x16B43, breakAL},
+	{0x16B44, 0x16B44, breakBA},
+	{0x16B45, 0x16B45, breakAL},
+	{0x16B50, 0x16B59, breakNU},
+	{0x16B5B, 0x16B61, breakAL},
+	{0x16B63, 0x16B77, breakAL},
+	{0x16B7D, 0x16B8F, breakAL},
+	{0x16E40, 0x16E96, breakAL},
+	{0x16E97, 0x16E98, breakBA},
+	{0x16E99, 0x16E9A, breakAL},
+	{0x16F00, 0x16F4A, breakAL},
+	{0x16F4F, 0x16F4F, breakCM},
+	{0x16F50, 0x16F50, breakAL},
+	{0x16F51, 0x16F87, breakCM},
+	{0x16F8F, 0x16F92, breakCM},
+	{0x16F93, 0x16F9F, breakAL},
+	{0x16FE0, 0x16FE3, breakNS},
+	{0x16FE4, 0x16FE4, breakGL},
+	{0x16FF0, 0x16FF1, breakCM},
+	{0x17000, 0x187F7, breakID},
+	{0x18800, 0x18AFF, breakID},
+	{0x18B00, 0x18CD5, breakAL},
+	{0x18D00, 0x18D08, breakID},
+	{0x1AFF0, 0x1AFF3, breakAL},
+	{0x1AFF5, 0x1AFFB, breakAL},
+	{0x1AFFD, 0x1AFFE, breakAL},
+	{0x1B000, 0x1B122, breakID},
+	{0x1B132, 0x1B132, breakCJ},
+	{0x1B150, 0x1B152, breakCJ},
+	{0x1B155, 0x1B155, breakCJ},
+	{0x1B164, 0x1B167, breakCJ},
+	{0x1B170, 0x1B2FB, breakID},
+	{0x1BC00, 0x1BC6A, breakAL},
+	{0x1BC70, 0x1BC7C, breakAL},
+	{0x1BC80, 0x1BC88, breakAL},
+	{0x1BC90, 0x1BC99, breakAL},
+	{0x1BC9C, 0x1BC9C, breakAL},
+	{0x1BC9D, 0x1BC9E, breakCM},
+	{0x1BC9F, 0x1BC9F, breakBA},
+	{0x1BCA0, 0x1BCA3, breakCM},
+	{0x1CF00, 0x1CF2D, breakCM},
+	{0x1CF30, 0x1CF46, breakCM},
+	{0x1CF50, 0x1CFC3, breakAL},
+	{0x1D000, 0x1D0F5, breakAL},
+	{0x1D100, 0x1D126, breakAL},
+	{0x1D129, 0x1D164, breakAL},
+	{0x1D165, 0x1D169, breakCM},
+	{0x1D16A, 0x1D16C, breakAL},
+	{0x1D16D, 0x1D182, breakCM},
+	{0x1D183, 0x1D184, breakAL},
+	{0x1D185, 0x1D18B, breakCM},
+	{0x1D18C, 0x1D1A9, breakAL},
+	{0x1D1AA, 0x1D1AD, breakCM},
+	{0x1D1AE, 0x1D1EA, breakAL},
+	{0x1D200, 0x1D241, breakAL},
+	{0x1D242, 0x1D244, breakCM},
+	{0x1D245, 0x1D245, breakAL},
+	{0x1D2C0, 0x1D2D3, breakAL},
+	{0x1D2E0, 0x1D2F3, breakAL},
+	{0x1D300, 0x1D356, breakAL},
+	{0x1D360, 0x1D378, breakAL},
+	{0x1D400, 0x1D454, breakAL},
+	{0x1D456, 0x1D49C, breakAL},
+	{0x1D49E, 0x1D49F, breakAL},
+	{0x1D4A2, 0x1D4A2, breakAL},
+	{0x1D4A5, 0x1D4A6, breakAL},
+	{0x1D4A9, 0x1D4AC, breakAL},
+	{0x1D4AE, 0x1D4B9, breakAL},
+	{0x1D4BB, 0x1D4BB, breakAL},
+	{0x1D4BD, 0x1D4C3, breakAL},
+	{0x1D4C5, 0x1D505, breakAL},
+	{0x1D507, 0x1D50A, breakAL},
+	{0x1D50D, 0x1D514, breakAL},
+	{0x1D516, 0x1D51C, breakAL},
+	{0x1D51E, 0x1D539, breakAL},
+	{0x1D53B, 0x1D53E, breakAL},
+	{0x1D540, 0x1D544, breakAL},
+	{0x1D546, 0x1D546, breakAL},
+	{0x1D54A, 0x1D550, breakAL},
+	{0x1D552, 0x1D6A5, breakAL},
+	{0x1D6A8, 0x1D7CB, breakAL},
+	{0x1D7CE, 0x1D7FF, breakNU},
+	{0x1D800, 0x1D9FF, breakAL},
+	{0x1DA00, 0x1DA36, breakCM},
+	{0x1DA37, 0x1DA3A, breakAL},
+	{0x1DA3B, 0x1DA6C, breakCM},
+	{0x1DA6D, 0x1DA74, breakAL},
+	{0x1DA75, 0x1DA75, breakCM},
+	{0x1DA76, 0x1DA83, breakAL},
+	{0x1DA84, 0x1DA84, breakCM},
+	{0x1DA85, 0x1DA86, breakAL},
+	{0x1DA87, 0x1DA8A, breakBA},
+	{0x1DA8B, 0x1DA8B, breakAL},
+	{0x1DA9B, 0x1DA9F, breakCM},
+	{0x1DAA1, 0x1DAAF, breakCM},
+	{0x1DF00, 0x1DF1E, breakAL},
+	{0x1DF25, 0x1DF2A, breakAL},
+	{0x1E000, 0x1E006, breakCM},
+	{0x1E008, 0x1E018, breakCM},
+	{0x1E01B, 0x1E021, breakCM},
+	{0x1E023, 0x1E024, breakCM},
+	{0x1E026, 0x1E02A, breakCM},
+	{0x1E030, 0x1E06D, breakAL},
+	{0x1E08F, 0x1E08F, breakCM},
+	{0x1E100, 0x1E12C, breakAL},
+	{0x1E130, 0x1E136, breakCM},
+	{0x1E137, 0x1E13D, breakAL},
+	{0x1E140, 0x1E149, breakNU},
+	{0x1E14E, 0x1E14F, breakAL},
+	{0x1E290, 0x1E2AD, breakAL},
+	{0x1E2AE, 0x1E2AE, breakCM},
+	{0x1E2C0, 0x1E2EB, breakAL},
+	{0x1E2EC, 0x1E2EF, breakCM},
+	{0x1E2F0, 0x1E2F9, breakNU},
+	{0x1E2FF, 0x1E2FF, breakPR},
+	{0x1E4D0, 0x1E4EB, breakAL},
+	{0x1E4EC, 0x1E4EF, breakCM},
+	{0x1E4F0, 0x1E4F9, breakNU},
+	{0x1E7E0, 0x1E7E6, breakAL},
+	{0x1E7E8, 0x1E7EB, breakAL},
+	{0x1E7ED, 0x1E7EE, breakAL},
+	{0x1E7F0, 0x1E7FE, breakAL},
+	{0x1E800, 0x1E8C4, breakAL},
+	{0x1E8C7, 0x1E8CF, breakAL},
+	{0x1E8D0, 0x1E8D6, breakCM},
+	{0x1E900, 0x1E943, breakAL},
+	{0x1E944, 0x1E94A, breakCM},
+	{0x1E94B, 0x1E94B, breakAL},
+	{0x1E950, 0x1E959, breakNU},
+	{0x1E95E, 0x1E95F, breakOP},
+	{0x1EC71, 0x1ECAB, breakAL},
+	{0x1ECAC, 0x1ECAC, breakPO},
+	{0x1ECAD, 0x1ECAF, breakAL},
+	{0x1ECB0, 0x1ECB0, breakPO},
+	{0x1ECB1, 0x1ECB4, breakAL},
+	{0x1ED01, 0x1ED3D, breakAL},
+	{0x1EE00, 0x1EE03, breakAL},
+	{0x1EE05, 0x1EE1F, breakAL},
+	{0x1EE21, 0x1EE22, breakAL},
+	{0x1EE24, 0x1EE24, breakAL},
+	{0x1EE27, 0x1EE27, breakAL},
+	{0x1EE29, 0x1EE32, breakAL},
+	{0x1EE34, 0x1EE37, breakAL},
+	{0x1EE39, 0x1EE39, breakAL},
+	{0x1EE3B, 0x1EE3B, breakAL},
+	{0x1EE42, 0x1EE42, breakAL},
+	{0x1EE47, 0x1EE47, breakAL},
+	{0x1EE49, 0x1EE49, breakAL},
+	{0x1EE4B, 0x1EE4B, breakAL},
+	{0x1EE4D, 0x1EE4F, breakAL},
+	{0x1EE51, 0x1EE52, breakAL},
+	{0x1EE54, 0x1EE54, breakAL},
+	{0x1EE57, 0x1EE57, breakAL},
+	{0x1EE59, 0x1EE59, breakAL},
+	{0x1EE5B, 0x1EE5B, breakAL},
+	{0x1EE5D, 0x1EE5D, breakAL},
+	{0x1EE5F, 0x1EE5F, breakAL},
+	{0x1EE61, 0x1EE62, breakAL},
+	{0x1EE64, 0x1EE64, breakAL},
+	{0x1EE67, 0x1EE6A, breakAL},
+	{0x1EE6C, 0x1EE72, breakAL},
+	{0x1EE74, 0x1EE77, breakAL},
+	{0x1EE79, 0x1EE7C, breakAL},
+	{0x1EE7E, 0x1EE7E, breakAL},
+	{0x1EE80, 0x1EE89, breakAL},
+	{0x1EE8B, 0x1EE9B, breakAL},
+	{0x1EEA1, 0x1EEA3, breakAL},
+	{0x1EEA5, 0x1EEA9, breakAL},
+	{0x1EEAB, 0x1EEBB, breakAL},
+	{0x1EEF0, 0x1EEF1, breakAL},
+	{0x1F000, 0x1F0FF, breakID},
+	{0x1F100, 0x1F10C, breakAI},
+	{0x1F10D, 0x1F10F, breakID},
+	{0x1F110, 0x1F12D, breakAI},
+	{0x1F12E, 0x1F12F, breakAL},
+	{0x1F130, 0x1F169, breakAI},
+	{0x1F16A, 0x1F16C, breakAL},
+	{0x1F16D, 0x1F16F, breakID},
+	{0x1F170, 0x1F1AC, breakAI},
+	{0x1F1AD, 0x1F1E5, breakID},
+	{0x1F1E6, 0x1F1FF, breakRI},
+	{0x1F200, 0x1F384, breakID},
+	{0x1F385, 0x1F385, breakEB},
+	{0x1F386, 0x1F39B, breakID},
+	{0x1F39C, 0x1F39D, breakAL},
+	{0x1F39E, 0x1F3B4, breakID},
+	{0x1F3B5, 0x1F3B6, breakAL},
+	{0x1F3B7, 0x1F3BB, breakID},
+	{0x1F3BC, 0x1F3BC, breakAL},
+	{0x1F3BD, 0x1F3C1, breakID},
+	{0x1F3C2, 0x1F3C4, breakEB},
+	{0x1F3C5, 0x1F3C6, breakID},
+	{0x1F3C7, 0x1F3C7, breakEB},
+	{0x1F3C8, 0x1F3C9, breakID},
+	{0x1F3CA, 0x1F3CC, breakEB},
+	{0x1F3CD, 0x1F3FA, breakID},
+	{0x1F3FB, 0x1F3FF, breakEM},
+	{0x1F400, 0x1F441, breakID},
+	{0x1F442, 0x1F443, breakEB},
+	{0x1F444, 0x1F445, breakID},
+	{0x1F446, 0x1F450, breakEB},
+	{0x1F451, 0x1F465, breakID},
+	{0x1F466, 0x1F478, breakEB},
+	{0x1F479, 0x1F47B, breakID},
+	{0x1F47C, 0x1F47C, breakEB},
+	{0x1F47D, 0x1F480, breakID},
+	{0x1F481, 0x1F483, breakEB},
+	{0x1F484, 0x1F484, breakID},
+	{0x1F485, 0x1F487, breakEB},
+	{0x1F488, 0x1F48E, breakID},
+	{0x1F48F, 0x1F48F, breakEB},
+	{0x1F490, 0x1F490, breakID},
+	{0x1F491, 0x1F491, breakEB},
+	{0x1F492, 0x1F49F, breakID},
+	{0x1F4A0, 0x1F4A0, breakAL},
+	{0x1F4A1, 0x1F4A1, breakID},
+	{0x1F4A2, 0x1F4A2, breakAL},
+	{0x1F4A3, 0x1F4A3, breakID},
+	{0x1F4A4, 0x1F4A4, breakAL},
+	{0x1F4A5, 0x1F4A9, breakID},
+	{0x1F4AA, 0x1F4AA, breakEB},
+	{0x1F4AB, 0x1F4AE, breakID},
+	{0x1F4AF, 0x1F4AF, breakAL},
+	{0x1F4B0, 0x1F4B0, breakID},
+	{0x1F4B1, 0x1F4B2, breakAL},
+	{0x1F4B3, 0x1F4FF, breakID},
+	{0x1F500, 0x1F506, breakAL},
+	{0x1F507, 0x1F516, breakID},
+	{0x1F517, 0x1F524, breakAL},
+	{0x1F525, 0x1F531, breakID},
+	{0x1F532, 0x1F549, breakAL},
+	{0x1F54A, 0x1F573, breakID},
+	{0x1F574, 0x1F575, breakEB},
+	{0x1F576, 0x1F579, breakID},
+	{0x1F57A, 0x1F57A, breakEB},
+	{0x1F57B, 0x1F58F, breakID},
+	{0x1F590, 0x1F590, breakEB},
+	{0x1F591, 0x1F594, breakID},
+	{0x1F595, 0x1F596, breakEB},
+	{0x1F597, 0x1F5D3, breakID},
+	{0x1F5D4, 0x1F5DB, breakAL},
+	{0x1F5DC, 0x1F5F3, breakID},
+	{0x1F5F4, 0x1F5F9, breakAL},
+	{0x1F5FA, 0x1F644, breakID},
+	{0x1F645, 0x1F647, breakEB},
+	{0x1F648, 0x1F64A, breakID},
+	{0x1F64B, 0x1F64F, breakEB},
+	{0x1F650, 0x1F675, breakAL},
+	{0x1F676, 0x1F678, breakQU},
+	{0x1F679, 0x1F67B, breakNS},
+	{0x1F67C, 0x1F67F, breakAL},
+	{0x1F680, 0x1F6A2, breakID},
+	{0x1F6A3, 0x1F6A3, breakEB},
+	{0x1F6A4, 0x1F6B3, breakID},
+	{0x1F6B4, 0x1F6B6, breakEB},
+	{0x1F6B7, 0x1F6BF, breakID},
+	{0x1F6C0, 0x1F6C0, breakEB},
+	{0x1F6C1, 0x1F6CB, breakID},
+	{0x1F6CC, 0x1F6CC, breakEB},
+	{0x1F6CD, 0x1F6FF, breakID},
+	{0x1F700, 0x1F773, breakAL},
+	{0x1F774, 0x1F77F, breakID},
+	{0x1F780, 0x1F7D4, breakAL},
+	{0x1F7D5, 0x1F7FF, breakID},
+	{0x1F800, 0x1F80B, breakAL},
+	{0x1F80C, 0x1F80F, breakID},
+	{0x1F810, 0x1F847, breakAL},
+	{0x1F848, 0x1F84F, breakID},
+	{0x1F850, 0x1F859, breakAL},
+	{0x1F85A, 0x1F85F, breakID},
+	{0x1F860, 0x1F887, breakAL},
+	{0x1F888, 0x1F88F, breakID},
+	{0x1F890, 0x1F8AD, breakAL},
+	{0x1F8AE, 0x1F8FF, breakID},
+	{0x1F900, 0x1F90B, breakAL},
+	{0x1F90C, 0x1F90C, breakEB},
+	{0x1F90D, 0x1F90E, breakID},
+	{0x1F90F, 0x1F90F, breakEB},
+	{0x1F910, 0x1F917, breakID},
+	{0x1F918, 0x1F91F, breakEB},
+	{0x1F920, 0x1F925, breakID},
+	{0x1F926, 0x1F926, breakEB},
+	{0x1F927, 0x1F92F, breakID},
+	{0x1F930, 0x1F939, breakEB},
+	{0x1F93A, 0x1F93B, breakID},
+	{0x1F93C, 0x1F93E, breakEB},
+	{0x1F93F, 0x1F976, breakID},
+	{0x1F977, 0x1F977, breakEB},
+	{0x1F978, 0x1F9B4, breakID},
+	{0x1F9B5, 0x1F9B6, breakEB},
+	{0x1F9B7, 0x1F9B7, breakID},
+	{0x1F9B8, 0x1F9B9, breakEB},
+	{0x1F9BA, 0x1F9BA, breakID},
+	{0x1F9BB, 0x1F9BB, breakEB},
+	{0x1F9BC, 0x1F9CC, breakID},
+	{0x1F9CD, 0x1F9CF, breakEB},
+	{0x1F9D0, 0x1F9D0, breakID},
+	{0x1F9D1, 0x1F9DD, breakEB},
+	{0x1F9DE, 0x1F9FF, breakID},
+	{0x1FA00, 0x1FA53, breakAL},
+	{0x1FA54, 0x1FAC2, breakID},
+	{0x1FAC3, 0x1FAC5, breakEB},
+	{0x1FAC6, 0x1FAEF, breakID},
+	{0x1FAF0, 0x1FAF8, breakEB},
+	{0x1FAF9, 0x1FAFF, breakID},
+	{0x1FB00, 0x1FB92, breakAL},
+	{0x1FB94, 0x1FBCA, breakAL},
+	{0x1FBF0, 0x1FBF9, breakNU},
+	{0x1FC00, 0x1FFFD, breakID},
+	{0x20000, 0x2FFFD, breakID},
+	{0x30000, 0x3FFFD, breakID},
+	{0xE0001, 0xE0001, breakCM},
+	{0xE0020, 0xE007F, breakCM},
+	{0xE0100, 0xE01EF, breakCM},
+	{0xF0000, 0xFFFFD, breakXX},
+	{0x100000, 0x10FFFD, breakXX},
+}
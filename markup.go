@@ -0,0 +1,256 @@
+package reportengine
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Style of the text while the markup is parsed
+type textStyle struct {
+	fontFamily    string
+	fontWeight    int
+	italic        bool
+	fontSize      int
+	color         Color
+	fallbacks     []string //Families used for the characters missing from the font
+	kerning       bool
+	ligatures     bool
+	fontMetrics   bool
+	letterSpacing float64
+	wordSpacing   float64
+	transform     int
+}
+
+// A style opened by the markup, closed by closer
+type markupFrame struct {
+	style  textStyle
+	closer string
+}
+
+var (
+	regexIcon  = regexp.MustCompile(`^i{(0x[a-zA-Z0-9]{4,5};#[a-zA-Z0-9]{6})}`)
+	regexField = regexp.MustCompile(`^f{(page|pages|sectionPage|sectionPages|date)(?:;([^}]*))?}`)
+	regexStyle = regexp.MustCompile(`^([cst]){([^;{}]*);`)
+	regexSpace = regexp.MustCompile(`\s+`)
+)
+
+func (t textStyle) token() Token {
+	return Token{fontFamily: resolveFontFamily(t.fontFamily, t.fontWeight, t.italic), fontSize: t.fontSize,
+		color: t.color, kerning: t.kerning, ligatures: t.ligatures, fontMetrics: t.fontMetrics,
+		letterSpacing: t.letterSpacing, wordSpacing: t.wordSpacing, transform: t.transform}
+}
+
+// Return the tokens of text, split in runs of the font of the style and of its fallbacks: every character
+// is written with the first font that has its glyph, or with the font of the style if none has it.
+// The whitespaces and the marks stay with the characters before them.
+func (t textStyle) textTokens(text string) []Token {
+	token := t.token()
+	if len(t.fallbacks) == 0 {
+		token.value = text
+		return []Token{token}
+	}
+	fonts := []string{token.fontFamily}
+	for _, family := range t.fallbacks {
+		fonts = append(fonts, resolveFontFamily(family, t.fontWeight, t.italic))
+	}
+	hasGlyph := func(font string, r rune) bool {
+		if forms, ok := arabicForms[r]; ok && !Fonts.HasGlyph(font, forms[0]) { //Shaped later
+			return false
+		}
+		return Fonts.HasGlyph(font, r)
+	}
+	tokens := make([]Token, 0)
+	start := 0
+	for i, r := range text {
+		font := token.fontFamily
+		if unicode.IsSpace(r) || unicode.In(r, unicode.Mn, unicode.Me) {
+			if i > 0 {
+				continue
+			}
+		} else {
+			for _, f := range fonts {
+				if hasGlyph(f, r) {
+					font = f
+					break
+				}
+			}
+		}
+		if i == 0 {
+			token.fontFamily = font
+		} else if font != token.fontFamily {
+			token.value = text[start:i]
+			tokens = append(tokens, token)
+			token.fontFamily, start = font, i
+		}
+	}
+	token.value = text[start:]
+	return append(tokens, token)
+}
+
+// Return the tokens of value, that can contain the markup:
+//   - **text** bold, __text__ italic
+//   - c{#RRGGBB;text} color, s{size;text} font size, t{family;text} font family
+//   - i{0xF0A43;#0000FF} icon, f{field} or f{field;layout} field (FieldPage, FieldDate, ...)
+//
+// The styles can be nested, a style not closed lasts until the end. A backslash escapes the next character,
+// so \{ \} \* \_ \; and \\ are written as they are. Markup not valid is written as it is.
+// If markup is false only the icons and the fields are parsed, the rest of the value is written as it is.
+func parseMarkup(value string, style textStyle, markup bool) []Token {
+	tokens := make([]Token, 0)
+	stack := []markupFrame{{style: style}}
+	var text strings.Builder
+	flush := func() {
+		if text.Len() > 0 {
+			tokens = append(tokens, stack[len(stack)-1].style.textTokens(text.String())...)
+			text.Reset()
+		}
+	}
+	for i := 0; i < len(value); {
+		current := stack[len(stack)-1].style
+		rest := value[i:]
+		if match := regexIcon.FindStringSubmatch(rest); match != nil {
+			flush()
+			token := current.token()
+			token.fontFamily = IconFontFamily
+			token.value, token.color = getIcon(match[1])
+			tokens = append(tokens, token)
+			i += len(match[0])
+			continue
+		}
+		if match := regexField.FindStringSubmatchIndex(rest); match != nil {
+			flush()
+			token := current.token()
+			token.field = rest[match[2]:match[3]]
+			if match[4] >= 0 {
+				token.fieldLayout = rest[match[4]:match[5]]
+			}
+			tokens = append(tokens, token)
+			i += match[1]
+			continue
+		}
+		if !markup { //Only the icons and the fields
+			text.WriteByte(rest[0])
+			i++
+			continue
+		}
+		if rest[0] == '\\' && len(rest) > 1 {
+			_, size := utf8.DecodeRuneInString(rest[1:])
+			text.WriteString(rest[1 : 1+size])
+			i += 1 + size
+			continue
+		}
+		if closer := markupCloser(stack, rest); closer >= 0 {
+			flush()
+			i += len(stack[closer].closer)
+			stack = stack[:closer]
+			continue
+		}
+		if strings.HasPrefix(rest, "**") || strings.HasPrefix(rest, "__") {
+			flush()
+			next := current
+			if rest[0] == '*' {
+				next.fontWeight = FontWeightBold
+			} else {
+				next.italic = true
+			}
+			stack = append(stack, markupFrame{style: next, closer: rest[:2]})
+			i += 2
+			continue
+		}
+		if match := regexStyle.FindStringSubmatch(rest); match != nil {
+			if next, ok := applyMarkupStyle(current, match[1], match[2]); ok {
+				flush()
+				stack = append(stack, markupFrame{style: next, closer: "}"})
+				i += len(match[0])
+				continue
+			}
+		}
+		text.WriteByte(rest[0])
+		i++
+	}
+	flush()
+	return tokens
+}
+
+// Return the index in stack of the style closed at the start of rest, or -1
+func markupCloser(stack []markupFrame, rest string) int {
+	for i := len(stack) - 1; i > 0; i-- {
+		if strings.HasPrefix(rest, stack[i].closer) {
+			return i
+		}
+	}
+	return -1
+}
+
+// Return style changed by the markup c{color;...}, s{size;...} or t{family;...}, false if the value is not valid
+func applyMarkupStyle(style textStyle, markup, value string) (textStyle, bool) {
+	switch markup {
+	case "c":
+		color, err := NewColor(value)
+		if err != nil {
+			return style, false
+		}
+		style.color = color
+	case "s":
+		size, err := strconv.Atoi(value)
+		if err != nil || size <= 0 {
+			return style, false
+		}
+		style.fontSize = size
+	case "t":
+		if value == "" {
+			return style, false
+		}
+		style.fontFamily = value
+	}
+	return style, true
+}
+
+// Split the tokens in words, the words are separated by spaces inside the text tokens.
+// Return also the number of newlines before every word.
+func splitWords(tokens []Token) ([][]Token, []int) {
+	words := make([][]Token, 0)
+	newlines := make([]int, 0)
+	word := make([]Token, 0)
+	pending := 0 //Newlines after the last word
+	add := func(token Token) {
+		if len(word) == 0 {
+			newlines = append(newlines, pending)
+			pending = 0
+		}
+		word = append(word, token)
+	}
+	for _, token := range tokens {
+		if token.field != "" || token.fontFamily == IconFontFamily {
+			add(token)
+			continue
+		}
+		value := token.value
+		for value != "" {
+			space := regexSpace.FindStringIndex(value)
+			if space == nil {
+				space = []int{len(value), len(value)}
+			}
+			if space[0] > 0 {
+				temp := token
+				temp.value = value[:space[0]]
+				add(temp)
+			}
+			if space[1] > space[0] {
+				if len(word) > 0 {
+					words = append(words, word)
+					word = make([]Token, 0)
+				}
+				pending += strings.Count(value[space[0]:space[1]], "\n")
+			}
+			value = value[space[1]:]
+		}
+	}
+	if len(word) > 0 {
+		words = append(words, word)
+	}
+	return words, newlines
+}
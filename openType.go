@@ -0,0 +1,389 @@
+package reportengine
+
+import (
+	"encoding/binary"
+	"github.com/signintech/gopdf/fontmaker/core"
+	"math/bits"
+	"sort"
+)
+
+// Data of a font read from its TrueType file: the vertical metrics, the characters, the kerning (GPOS or kern table)
+// and the standard ligatures (GSUB)
+type fontInfo struct {
+	unitsPerEm float64
+	ascender   float64               //Above the baseline
+	descender  float64               //Below the baseline, positive
+	lineGap    float64               //Between the descender of a line and the ascender of the next
+	chars      map[int]uint          //Character -> glyph
+	runes      map[uint]rune         //Glyph -> character, the ligatures are written with their character
+	kerning    [][]pairAdjustment    //Subtables of the kerning lookups
+	ligatures  map[uint16][]ligature //First glyph -> its ligatures, in order of preference
+	smallCaps  map[uint16]uint16     //Glyph -> its small capital (GSUB smcp)
+}
+
+// Subtable of pair adjustment, ok is false if it doesn't apply to the pair
+type pairAdjustment interface {
+	adjustment(first, second uint16) (value int16, ok bool)
+}
+
+// Kerning of pairs of glyphs (GPOS pair adjustment format 1)
+type pairKerning struct {
+	coverage map[uint16]int
+	pairs    map[[2]uint16]int16
+}
+
+// Kerning of classes of glyphs (GPOS pair adjustment format 2)
+type classKerning struct {
+	coverage       map[uint16]int
+	class1, class2 map[uint16]uint16
+	class1Count    int
+	class2Count    int
+	values         []int16 //class1*class2Count + class2
+}
+
+// Kerning of the kern table, for the fonts without GPOS
+type kernTable core.KernMap
+
+type ligature struct {
+	components []uint16 //Glyphs after the first
+	glyph      uint16
+}
+
+// Tables of an OpenType font, the values out of the data are 0
+type openTypeData []byte
+
+func (t openTypeData) u16(offset int) uint16 {
+	if offset < 0 || offset+2 > len(t) {
+		return 0
+	}
+	return binary.BigEndian.Uint16(t[offset:])
+}
+func (t openTypeData) u32(offset int) uint32 {
+	if offset < 0 || offset+4 > len(t) {
+		return 0
+	}
+	return binary.BigEndian.Uint32(t[offset:])
+}
+func (t openTypeData) tag(offset int) string {
+	if offset < 0 || offset+4 > len(t) {
+		return ""
+	}
+	return string(t[offset : offset+4])
+}
+
+func parseFontInfo(data []byte) (*fontInfo, error) {
+	parser := core.TTFParser{}
+	parser.SetUseKerning(true)
+	if err := parser.ParseFontData(data); err != nil {
+		parser = core.TTFParser{} //The kern table is not valid
+		if err = parser.ParseFontData(data); err != nil {
+			return nil, err
+		}
+	}
+	info := &fontInfo{unitsPerEm: float64(parser.UnitsPerEm()), chars: parser.Chars(), runes: make(map[uint]rune),
+		ligatures: make(map[uint16][]ligature), smallCaps: make(map[uint16]uint16)}
+	for r, glyph := range info.chars {
+		if previous, ok := info.runes[glyph]; !ok || rune(r) < previous {
+			info.runes[glyph] = rune(r)
+		}
+	}
+	d := openTypeData(data)
+	tables := parser.GetTables()
+	info.setMetrics(d, tables)
+	if table, ok := tables["GPOS"]; ok {
+		for _, lookup := range d.featureLookups(int(table.Offset), "kern", 2, 9) {
+			subtables := make([]pairAdjustment, 0, len(lookup))
+			for _, subtable := range lookup {
+				if adjustment := d.pairAdjustment(subtable); adjustment != nil {
+					subtables = append(subtables, adjustment)
+				}
+			}
+			info.kerning = append(info.kerning, subtables)
+		}
+	}
+	if len(info.kerning) == 0 && parser.Kern() != nil {
+		info.kerning = [][]pairAdjustment{{kernTable(parser.Kern().Kerning)}}
+	}
+	if table, ok := tables["GSUB"]; ok {
+		for _, lookup := range d.featureLookups(int(table.Offset), "liga", 4, 7) {
+			for _, subtable := range lookup {
+				d.addLigatures(subtable, info.ligatures)
+			}
+		}
+		for _, lookup := range d.featureLookups(int(table.Offset), "smcp", 1, 7) {
+			for _, subtable := range lookup {
+				d.addSubstitutions(subtable, info.smallCaps)
+			}
+		}
+	}
+	return info, nil
+}
+
+// Set the vertical metrics: the typographic ones of the OS/2 table if the font asks to use them (USE_TYPO_METRICS),
+// otherwise the ones of the hhea table, or the Windows ones of the OS/2 table if hhea has none
+func (t *fontInfo) setMetrics(d openTypeData, tables map[string]core.TableDirectoryEntry) {
+	os2, hasOS2 := tables["OS/2"]
+	hhea, hasHhea := tables["hhea"]
+	switch {
+	case hasOS2 && d.u16(int(os2.Offset)+62)&(1<<7) != 0:
+		offset := int(os2.Offset)
+		t.ascender = float64(int16(d.u16(offset + 68)))
+		t.descender = -float64(int16(d.u16(offset + 70)))
+		t.lineGap = float64(int16(d.u16(offset + 72)))
+	case hasHhea && d.u16(int(hhea.Offset)+4) != 0:
+		offset := int(hhea.Offset)
+		t.ascender = float64(int16(d.u16(offset + 4)))
+		t.descender = -float64(int16(d.u16(offset + 6)))
+		t.lineGap = float64(int16(d.u16(offset + 8)))
+	case hasOS2:
+		t.ascender = float64(d.u16(int(os2.Offset) + 74))
+		t.descender = float64(d.u16(int(os2.Offset) + 76))
+	}
+	if t.descender < 0 { //Positive in some fonts
+		t.descender = -t.descender
+	}
+	if t.lineGap < 0 {
+		t.lineGap = 0
+	}
+}
+
+// Return the offsets of the subtables of lookupType of the lookups of the feature tag, in the order
+// of the lookup list. The subtables of the extension lookups (extensionType) are resolved.
+func (t openTypeData) featureLookups(table int, tag string, lookupType, extensionType uint16) [][]int {
+	featureList := table + int(t.u16(table+6))
+	lookupList := table + int(t.u16(table+8))
+	indexes := make([]int, 0)
+	added := make(map[int]bool)
+	for i := 0; i < int(t.u16(featureList)); i++ {
+		record := featureList + 2 + 6*i
+		if t.tag(record) != tag {
+			continue
+		}
+		feature := featureList + int(t.u16(record+4))
+		for j := 0; j < int(t.u16(feature+2)); j++ {
+			index := int(t.u16(feature + 4 + 2*j))
+			if !added[index] && index < int(t.u16(lookupList)) {
+				added[index] = true
+				indexes = append(indexes, index)
+			}
+		}
+	}
+	sort.Ints(indexes)
+	lookups := make([][]int, 0, len(indexes))
+	for _, index := range indexes {
+		lookup := lookupList + int(t.u16(lookupList+2+2*index))
+		kind := t.u16(lookup)
+		subtables := make([]int, 0)
+		for j := 0; j < int(t.u16(lookup+4)); j++ {
+			subtable := lookup + int(t.u16(lookup+6+2*j))
+			if kind == extensionType && t.u16(subtable+2) == lookupType {
+				subtable += int(t.u32(subtable + 4))
+			} else if kind != lookupType {
+				continue
+			}
+			subtables = append(subtables, subtable)
+		}
+		lookups = append(lookups, subtables)
+	}
+	return lookups
+}
+
+// Return the coverage index of the glyphs of the coverage table
+func (t openTypeData) coverage(offset int) map[uint16]int {
+	coverage := make(map[uint16]int)
+	switch t.u16(offset) {
+	case 1:
+		for i := 0; i < int(t.u16(offset+2)); i++ {
+			coverage[t.u16(offset+4+2*i)] = i
+		}
+	case 2:
+		for i := 0; i < int(t.u16(offset+2)); i++ {
+			record := offset + 4 + 6*i
+			start, end, index := int(t.u16(record)), int(t.u16(record+2)), int(t.u16(record+4))
+			for glyph := start; glyph <= end; glyph++ {
+				coverage[uint16(glyph)] = index + glyph - start
+			}
+		}
+	}
+	return coverage
+}
+
+// Return the class of the glyphs of the class definition table, the glyphs not in it are of class 0
+func (t openTypeData) classDef(offset int) map[uint16]uint16 {
+	classes := make(map[uint16]uint16)
+	switch t.u16(offset) {
+	case 1:
+		start := int(t.u16(offset + 2))
+		for i := 0; i < int(t.u16(offset+4)); i++ {
+			classes[uint16(start+i)] = t.u16(offset + 6 + 2*i)
+		}
+	case 2:
+		for i := 0; i < int(t.u16(offset+2)); i++ {
+			record := offset + 4 + 6*i
+			for glyph := int(t.u16(record)); glyph <= int(t.u16(record+2)); glyph++ {
+				classes[uint16(glyph)] = t.u16(record + 4)
+			}
+		}
+	}
+	return classes
+}
+
+// Return the kerning of a pair adjustment subtable, nil if its format is not known
+func (t openTypeData) pairAdjustment(subtable int) pairAdjustment {
+	coverage := t.coverage(subtable + int(t.u16(subtable+2)))
+	valueFormat1, valueFormat2 := t.u16(subtable+4), t.u16(subtable+6)
+	size := 2 * (bits.OnesCount16(valueFormat1) + bits.OnesCount16(valueFormat2))
+	xAdvance := func(record int) int16 { //Of the first glyph
+		if valueFormat1&4 == 0 {
+			return 0
+		}
+		return int16(t.u16(record + 2*bits.OnesCount16(valueFormat1&3)))
+	}
+	switch t.u16(subtable) {
+	case 1:
+		kerning := pairKerning{coverage: coverage, pairs: make(map[[2]uint16]int16)}
+		for first, index := range coverage {
+			if index >= int(t.u16(subtable+8)) {
+				continue
+			}
+			set := subtable + int(t.u16(subtable+10+2*index))
+			for j := 0; j < int(t.u16(set)); j++ {
+				record := set + 2 + j*(2+size)
+				kerning.pairs[[2]uint16{first, t.u16(record)}] = xAdvance(record + 2)
+			}
+		}
+		return kerning
+	case 2:
+		kerning := classKerning{coverage: coverage, class1: t.classDef(subtable + int(t.u16(subtable+8))),
+			class2: t.classDef(subtable + int(t.u16(subtable+10))), class1Count: int(t.u16(subtable + 12)),
+			class2Count: int(t.u16(subtable + 14))}
+		kerning.values = make([]int16, kerning.class1Count*kerning.class2Count)
+		for i := range kerning.values {
+			kerning.values[i] = xAdvance(subtable + 16 + i*size)
+		}
+		return kerning
+	}
+	return nil
+}
+
+// Add the substitutions of a single substitution subtable, the glyphs already substituted are kept
+func (t openTypeData) addSubstitutions(subtable int, substitutions map[uint16]uint16) {
+	format := t.u16(subtable)
+	for glyph, index := range t.coverage(subtable + int(t.u16(subtable+2))) {
+		if _, ok := substitutions[glyph]; ok {
+			continue
+		}
+		switch {
+		case format == 1:
+			substitutions[glyph] = glyph + t.u16(subtable+4) //Delta modulo 65536
+		case format == 2 && index < int(t.u16(subtable+4)):
+			substitutions[glyph] = t.u16(subtable + 6 + 2*index)
+		}
+	}
+}
+
+// Add the ligatures of a ligature substitution subtable
+func (t openTypeData) addLigatures(subtable int, ligatures map[uint16][]ligature) {
+	if t.u16(subtable) != 1 {
+		return
+	}
+	for first, index := range t.coverage(subtable + int(t.u16(subtable+2))) {
+		if index >= int(t.u16(subtable+4)) {
+			continue
+		}
+		set := subtable + int(t.u16(subtable+6+2*index))
+		for j := 0; j < int(t.u16(set)); j++ {
+			offset := set + int(t.u16(set+2+2*j))
+			l := ligature{glyph: t.u16(offset)}
+			for k := 1; k < int(t.u16(offset+2)); k++ {
+				l.components = append(l.components, t.u16(offset+2+2*k))
+			}
+			ligatures[first] = append(ligatures[first], l)
+		}
+	}
+}
+
+func (t pairKerning) adjustment(first, second uint16) (int16, bool) {
+	if _, ok := t.coverage[first]; !ok {
+		return 0, false
+	}
+	value, ok := t.pairs[[2]uint16{first, second}]
+	return value, ok
+}
+func (t classKerning) adjustment(first, second uint16) (int16, bool) {
+	if _, ok := t.coverage[first]; !ok {
+		return 0, false
+	}
+	class1, class2 := int(t.class1[first]), int(t.class2[second])
+	if class1 >= t.class1Count || class2 >= t.class2Count {
+		return 0, true
+	}
+	return t.values[class1*t.class2Count+class2], true
+}
+func (t kernTable) adjustment(first, second uint16) (int16, bool) {
+	value, ok := t[uint(first)][uint(second)]
+	return value, ok
+}
+
+// Return the kerning between the characters a and b, in units of the font
+func (t *fontInfo) kern(a, b rune) int {
+	first, second := uint16(t.chars[int(a)]), uint16(t.chars[int(b)])
+	if first == 0 || second == 0 {
+		return 0
+	}
+	kerning := 0
+	for _, lookup := range t.kerning {
+		for _, subtable := range lookup {
+			if value, ok := subtable.adjustment(first, second); ok {
+				kerning += int(value)
+				break
+			}
+		}
+	}
+	return kerning
+}
+
+// Replace the characters of the ligatures with the character of the ligature glyph.
+// The ligatures whose glyph has no character can't be written, so they are not used.
+func (t *fontInfo) applyLigatures(text string) string {
+	runes := []rune(text)
+	result := make([]rune, 0, len(runes))
+	for i := 0; i < len(runes); i++ {
+		r, length := t.ligature(runes[i:])
+		result = append(result, r)
+		i += length - 1
+	}
+	return string(result)
+}
+
+// Return the character of the ligature at the start of runes and the number of characters it replaces,
+// or the first character and 1
+func (t *fontInfo) ligature(runes []rune) (rune, int) {
+	first := uint16(t.chars[int(runes[0])])
+	for _, l := range t.ligatures[first] {
+		if first == 0 || len(l.components) >= len(runes) {
+			continue
+		}
+		match := true
+		for k, component := range l.components {
+			if uint16(t.chars[int(runes[k+1])]) != component {
+				match = false
+				break
+			}
+		}
+		if r, ok := t.runes[uint(l.glyph)]; match && ok {
+			return r, len(l.components) + 1
+		}
+	}
+	return runes[0], 1
+}
+
+// Return the character of the small capital of r, false if the font has none written with a character
+func (t *fontInfo) smallCap(r rune) (rune, bool) {
+	glyph, ok := t.smallCaps[uint16(t.chars[int(r)])]
+	if !ok || t.chars[int(r)] == 0 {
+		return r, false
+	}
+	c, ok := t.runes[uint(glyph)]
+	return c, ok
+}
@@ -0,0 +1,183 @@
+package reportengine
+
+import (
+	"fmt"
+	"github.com/signintech/gopdf"
+	"sort"
+)
+
+// SetOverflow sets what is done when the text is wider than the cell (OverflowEllipsisEnd, OverflowShrink, ...)
+func (t *CellText) SetOverflow(overflow int) {
+	t.overflow = overflow
+}
+
+// SetMinFontSize sets the minimum font size of OverflowShrink
+func (t *CellText) SetMinFontSize(size int) {
+	t.minFontSize = size
+}
+
+// Make the text fit in maxWidth (margins included) with the overflow policy of the cell
+func (t *CellText) fit(pdf *gopdf.GoPdf, maxWidth float64) {
+	width := t.textSpace(maxWidth)
+	overflow := t.overflow
+	if overflow == OverflowWrap && t.rotation != 0 { //The lines of a text area are not rotated
+		overflow = OverflowEllipsisEnd
+	}
+	switch overflow {
+	case OverflowError:
+		panic(newComponentError(t, "Build", fmt.Errorf("%w: %q is %.2f wide, %.2f available",
+			ErrTextOverflow, t.originalValue, t.textWidth(pdf), width)))
+	case OverflowWrap:
+		t.wrap(pdf, maxWidth)
+	case OverflowClip:
+		t.clip(pdf, width)
+	case OverflowShrink:
+		if !t.shrink(pdf, width) {
+			t.ellipsis(pdf, width, OverflowEllipsisEnd)
+		}
+	default:
+		t.ellipsis(pdf, width, overflow)
+	}
+}
+
+// Replace the end, the start or the middle of the text with ShortenCharacters, keeping as many characters
+// (and icons and fields, that are never cut) as possible
+func (t *CellText) ellipsis(pdf *gopdf.GoPdf, width float64, overflow int) {
+	tokens := t.tokens
+	units := wordUnits(tokens)
+	n := len(units)
+	ellipsis := func(neighbor []Token, last bool) []Token {
+		token := Token{fontFamily: t.textFontFamily(), fontSize: t.fontSize, color: t.color, context: t.pageContext,
+			fontMetrics: t.fontMetrics}
+		if len(neighbor) > 0 {
+			temp := neighbor[0]
+			if last {
+				temp = neighbor[len(neighbor)-1]
+			}
+			if temp.field == "" && temp.fontFamily != IconFontFamily { //The style of the text next to it
+				token = temp
+				token.gap = false
+			}
+		}
+		token.value = ShortenCharacters
+		return []Token{token}
+	}
+	var shortened func(kept int) []Token
+	var isKept func(kept, unit int) bool
+	switch overflow {
+	case OverflowEllipsisStart:
+		shortened = func(kept int) []Token {
+			tail := tailTokens(tokens, units, n-kept)
+			return append(ellipsis(tail, false), tail...)
+		}
+		isKept = func(kept, unit int) bool { return unit >= n-kept }
+	case OverflowEllipsisMiddle:
+		shortened = func(kept int) []Token {
+			head, tail := headTokens(tokens, units, (kept+1)/2), tailTokens(tokens, units, n-kept/2)
+			return append(append(head, ellipsis(head, true)...), tail...)
+		}
+		isKept = func(kept, unit int) bool { return unit < (kept+1)/2 || unit >= n-kept/2 }
+	default:
+		shortened = func(kept int) []Token {
+			head := headTokens(tokens, units, kept)
+			return append(head, ellipsis(head, true)...)
+		}
+		isKept = func(kept, unit int) bool { return unit < kept }
+	}
+	//Units kept, from 0 (only the ellipsis) to n-1
+	kept := sort.Search(n, func(kept int) bool {
+		return tokensWidth(pdf, shortened(kept)) > width
+	}) - 1
+	if kept < 0 { //Not even the ellipsis
+		t.tokens = make([]Token, 0)
+	} else {
+		t.tokens = shortened(kept)
+	}
+	t.recordTruncation(tokens, units, func(unit int) bool { return kept >= 0 && isKept(kept, unit) }, "ellipsis")
+}
+
+// Cut the text after the last character that fits
+func (t *CellText) clip(pdf *gopdf.GoPdf, width float64) {
+	units := wordUnits(t.tokens)
+	kept := sort.Search(len(units)+1, func(kept int) bool {
+		return tokensWidth(pdf, headTokens(t.tokens, units, kept)) > width
+	}) - 1
+	original := t.tokens
+	t.tokens = headTokens(t.tokens, units, kept)
+	t.recordTruncation(original, units, func(unit int) bool { return unit < kept }, "clip")
+}
+
+// Reduce the font size of the tokens, in proportion, down to minFontSize.
+// Return false if the text doesn't fit even with the minimum size, the tokens are left with it.
+func (t *CellText) shrink(pdf *gopdf.GoPdf, width float64) bool {
+	minFontSize := t.minFontSize
+	if minFontSize <= 0 {
+		minFontSize = DefaultMinFontSize
+	}
+	original := t.tokens
+	for size := t.fontSize - 1; size >= minFontSize; size-- {
+		t.tokens = make([]Token, len(original))
+		for i, token := range original {
+			token.fontSize = token.fontSize * size / t.fontSize
+			if token.fontSize < 1 {
+				token.fontSize = 1
+			}
+			t.tokens[i] = token
+		}
+		if t.textWidth(pdf) <= width {
+			t.recordShrink(size)
+			return true
+		}
+	}
+	if minFontSize < t.fontSize {
+		t.recordShrink(minFontSize)
+	}
+	return false
+}
+
+// Lay the text out on more lines, with a text area that breaks the words too long
+func (t *CellText) wrap(pdf *gopdf.GoPdf, maxWidth float64) {
+	area := NewCellTextArea(t.horizontalAlign, t.verticalAlign, t.originalValue, t.underline, t.fontFamily,
+		t.fontSize, t.color, t.minMarginText, t.rectangle)
+	area.fontWeight = t.fontWeight
+	area.italic = t.italic
+	area.fontFallbacks = t.fontFallbacks
+	area.kerning = t.kerning
+	area.ligatures = t.ligatures
+	area.fontMetrics = t.fontMetrics
+	area.letterSpacing = t.letterSpacing
+	area.wordSpacing = t.wordSpacing
+	area.textTransform = t.textTransform
+	area.markup = t.markup
+	area.setCellsText()
+	area.SetLineHeight(0) //No space between the lines, as high as the text like a CellText
+	area.SetWrap(WrapBreakWord)
+	area.SetPageContext(t.pageContext)
+	area.Build(pdf, maxWidth)
+	t.wrapped = area
+}
+
+// Return the tokens of the first k units
+func headTokens(tokens []Token, units []wordUnit, k int) []Token {
+	if k >= len(units) {
+		return append([]Token{}, tokens...)
+	}
+	head, _ := splitTokens(tokens, units[k])
+	return head
+}
+
+// Return the tokens from the unit k
+func tailTokens(tokens []Token, units []wordUnit, k int) []Token {
+	if k >= len(units) {
+		return make([]Token, 0)
+	}
+	_, tail := splitTokens(tokens, units[k])
+	return tail
+}
+func tokensWidth(pdf *gopdf.GoPdf, tokens []Token) float64 {
+	tot := 0.0
+	for i := range tokens {
+		tot += tokens[i].Width(pdf)
+	}
+	return tot
+}
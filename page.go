@@ -3,13 +3,26 @@ package reportengine
 import (
 	"github.com/signintech/gopdf"
 	"sort"
+	"time"
 )
 
 type Page struct {
+	pageSize  gopdf.Rect
 	rectangle Rectangle
 	//header    Component
 	//footer    Component
-	content []Component
+	content     []Component
+	context     PageContext
+	diagnostics []Diagnostic //Diagnostics of the components of the page, without the page number
+}
+
+// PageContext contains the values of the fields (f{page}, f{pages}, ...) of the components rendered in a page
+type PageContext struct {
+	Page         int
+	Pages        int
+	SectionPage  int
+	SectionPages int
+	Date         time.Time
 }
 
 func NewPage(rectangle Rectangle, header, footer Component) Page {
@@ -76,10 +89,19 @@ func (t *Page) getFirstVoidSpace() (lowerX, lowerY, width, height float64) {
 }
 
 func (t *Page) Render(pdf *gopdf.GoPdf) {
-	pdf.AddPage()
+	if t.pageSize.W > 0 && t.pageSize.H > 0 {
+		pageSize := t.pageSize
+		pdf.AddPageWithOption(gopdf.PageOption{PageSize: &pageSize})
+	} else {
+		pdf.AddPage()
+	}
 	//t.header.Render(pdf)
 	for i := range t.content {
-		t.content[i].Render(pdf)
+		setPageContext(t.content[i], t.context)
+		err := SafeRender(t.content[i], pdf)
+		if err != nil {
+			panic(asComponentError(t.content[i], "Render", err))
+		}
 	}
 	//t.footer.Render(pdf)
 }
@@ -0,0 +1,137 @@
+package reportengine
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+)
+
+// Pages rendered in a document before it is written by Report.Stream
+const streamChunkPages = 50
+
+var (
+	pdfObjectStart = regexp.MustCompile(`(?m)^(\d+) 0 obj\s`)
+	pdfReference   = regexp.MustCompile(`(\d+) 0 R\b`)
+	pdfLength      = regexp.MustCompile(`/Length (\d+)`)
+	pdfCount       = regexp.MustCompile(`/Count (\d+)`)
+)
+
+// pdfStreamWriter writes a document made of the pages of the documents (chunks) rendered by gopdf, every chunk
+// is written as soon as it is complete. The objects of a chunk are numbered after the ones already written,
+// its page tree becomes a child of the page tree of the document (object 1, the catalog is object 2).
+type pdfStreamWriter struct {
+	w       io.Writer
+	written int64
+	offsets []int64 //offsets[i] is the offset of the object i+1
+	kids    []int   //Page trees of the chunks
+	pages   int
+}
+
+func newPdfStreamWriter(w io.Writer) *pdfStreamWriter {
+	return &pdfStreamWriter{w: w, offsets: make([]int64, 2)}
+}
+
+func (t *pdfStreamWriter) write(data []byte) error {
+	n, err := t.w.Write(data)
+	t.written += int64(n)
+	return err
+}
+
+// Write the objects of the document chunk, the header of the first chunk is the header of the document
+func (t *pdfStreamWriter) writeChunk(chunk []byte) error {
+	loc := pdfObjectStart.FindIndex(chunk)
+	if loc == nil {
+		return fmt.Errorf("no object in the document")
+	}
+	if t.written == 0 {
+		if err := t.write(chunk[:loc[0]]); err != nil {
+			return err
+		}
+	}
+	base := len(t.offsets)
+	renumber := func(dictionary []byte) []byte {
+		return pdfReference.ReplaceAllFunc(dictionary, func(reference []byte) []byte {
+			number, _ := strconv.Atoi(string(pdfReference.FindSubmatch(reference)[1]))
+			return []byte(strconv.Itoa(number+base) + " 0 R")
+		})
+	}
+	for position := 0; ; {
+		//Only blanks between the objects, the binary data of the streams is skipped
+		loc := pdfObjectStart.FindSubmatchIndex(chunk[position:])
+		if loc == nil {
+			return nil
+		}
+		number, _ := strconv.Atoi(string(chunk[position+loc[2] : position+loc[3]]))
+		body := position + loc[1]
+		end := bytes.Index(chunk[body:], []byte("endobj"))
+		if end < 0 {
+			return fmt.Errorf("object %d not terminated", number)
+		}
+		dictionary, rest := chunk[body:body+end], []byte("endobj\n")
+		if stream := bytes.Index(chunk[body:], []byte("stream")); stream >= 0 && stream < end {
+			dictionary = chunk[body : body+stream]
+			length := pdfLength.FindSubmatch(dictionary)
+			if length == nil {
+				return fmt.Errorf("stream of object %d without length", number)
+			}
+			size, _ := strconv.Atoi(string(length[1]))
+			start := body + stream
+			data := start + len("stream")
+			if bytes.HasPrefix(chunk[data:], []byte("\r")) {
+				data++
+			}
+			data++ //The end of line before the data
+			end = bytes.Index(chunk[data+size:], []byte("endobj"))
+			if end < 0 {
+				return fmt.Errorf("object %d not terminated", number)
+			}
+			rest = append(append([]byte{}, chunk[start:data+size+end]...), rest...)
+			end += data + size - body
+		}
+		dictionary = renumber(dictionary)
+		if bytes.Contains(dictionary, []byte("/Type /Pages")) {
+			count := pdfCount.FindSubmatch(dictionary)
+			if count == nil {
+				return fmt.Errorf("page tree %d without count", number)
+			}
+			pages, _ := strconv.Atoi(string(count[1]))
+			t.pages += pages
+			t.kids = append(t.kids, number+base)
+			dictionary = bytes.Replace(dictionary, []byte("/Type /Pages"), []byte("/Type /Pages\n  /Parent 1 0 R"), 1)
+		}
+		for len(t.offsets) < number+base {
+			t.offsets = append(t.offsets, 0)
+		}
+		t.offsets[number+base-1] = t.written
+		if err := t.write(append(append([]byte(strconv.Itoa(number+base)+" 0 obj\n"), dictionary...), rest...)); err != nil {
+			return err
+		}
+		position = body + end + len("endobj")
+	}
+}
+
+// Write the page tree and the catalog of the document, then the cross-reference table
+func (t *pdfStreamWriter) close() error {
+	kids := ""
+	for _, kid := range t.kids {
+		kids += fmt.Sprintf(" %d 0 R ", kid)
+	}
+	t.offsets[0] = t.written
+	if err := t.write([]byte(fmt.Sprintf("1 0 obj\n<<\n  /Type /Pages\n  /Count %d\n  /Kids [ %s ]\n>>\nendobj\n\n",
+		t.pages, kids))); err != nil {
+		return err
+	}
+	t.offsets[1] = t.written
+	if err := t.write([]byte("2 0 obj\n<<\n  /Type /Catalog\n  /Pages 1 0 R\n>>\nendobj\n\n")); err != nil {
+		return err
+	}
+	xref := t.written
+	table := fmt.Sprintf("xref\n0 %d\n0000000000 65535 f \n", len(t.offsets)+1)
+	for _, offset := range t.offsets {
+		table += fmt.Sprintf("%010d 00000 n \n", offset)
+	}
+	table += fmt.Sprintf("trailer\n<<\n/Size %d\n/Root 2 0 R\n>>\nstartxref\n%d\n%%%%EOF\n", len(t.offsets)+1, xref)
+	return t.write([]byte(table))
+}
@@ -1,12 +1,54 @@
 package reportengine
 
 const (
-	UnderlineWidthFactor     = 0.05
-	UnderlineMargin          = 1
-	IconFontFamily           = "MaterialDesignIcons"
-	RootDirectoryFonts       = "../"
-	ShortenCharacters        = "..."
-	MaxIterationInfiniteLoop = 1024
+	UnderlineWidthFactor = 0.05
+	UnderlineMargin      = 1
+	IconFontFamily       = "MaterialDesignIcons"
+	ShortenCharacters    = "..."
+)
+
+// Justify is the horizontal alignment of CellTextArea that spreads the words of every line but the last
+// on the whole width, besides gopdf.Left, gopdf.Center and gopdf.Right (a CellText is aligned left)
+const Justify = 64
+
+// Default line height of a CellTextArea, as a multiple of the line spacing of the font (SetLineHeight)
+const DefaultLineHeight = 1.58
+
+// Wrap modes of the words wider than a CellTextArea (SetWrap)
+const (
+	WrapWord = iota
+	WrapBreakWord
+)
+
+// Overflow policies of a CellText with a text wider than the cell (SetOverflow)
+const (
+	OverflowEllipsisEnd    = iota //The end of the text is replaced by ShortenCharacters (default)
+	OverflowEllipsisStart         //The start of the text is replaced by ShortenCharacters
+	OverflowEllipsisMiddle        //The middle of the text is replaced by ShortenCharacters
+	OverflowClip                  //The text is cut after the last character that fits
+	OverflowShrink                //The font is reduced down to the minimum size (SetMinFontSize), then OverflowEllipsisEnd
+	OverflowError                 //Build fails with ErrTextOverflow
+	OverflowWrap                  //The text is wrapped on more lines
+)
+
+// Minimum font size of OverflowShrink if not set
+const DefaultMinFontSize = 6
+
+// Case transforms of the text (SetTextTransform)
+const (
+	TextTransformNone = iota
+	TextTransformUppercase
+	TextTransformLowercase
+	TextTransformSmallCaps //The small capitals of the font, or capitals of size SmallCapsScale if it has none
+)
+
+// Size of the synthesized small capitals, as a fraction of the font size
+const SmallCapsScale = 0.7
+
+// Languages of the hyphenation patterns (CellTextArea.SetHyphenation)
+const (
+	HyphenationItalian = "it"
+	HyphenationEnglish = "en"
 )
 
 const (
@@ -20,4 +62,33 @@ const (
 	SplitRepeatFirstRow
 )
 
+// Weights of the fonts, for CellText.SetFontWeight and FontRegistry.Resolve
+const (
+	FontWeightThin       = 100
+	FontWeightExtraLight = 200
+	FontWeightLight      = 300
+	FontWeightRegular    = 400
+	FontWeightMedium     = 500
+	FontWeightSemiBold   = 600
+	FontWeightBold       = 700
+	FontWeightExtraBold  = 800
+	FontWeightBlack      = 900
+)
+
+const (
+	PageNumberingContinue = iota
+	PageNumberingRestart
+	PageNumberingNone
+)
+
+// Fields usable in the text of the components as f{field} (f{date;layout} for a custom time layout)
+const (
+	FieldPage         = "page"
+	FieldPages        = "pages"
+	FieldSectionPage  = "sectionPage"
+	FieldSectionPages = "sectionPages"
+	FieldDate         = "date"
+	FieldDateLayout   = "02/01/2006"
+)
+
 const ImgUnsupportedFormat = "data:image/png;base64,iVBORw0KGgoAAAANSUhEUgAAAIAAAACACAMAAAD04JH5AAAAA3NCSVQICAjb4U/gAAAACXBIWXMAAAN2AAADdgF91YLMAAAAGXRFWHRTb2Z0d2FyZQB3d3cuaW5rc2NhcGUub3Jnm+48GgAAAX1QTFRF////AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAc0IzEgAAAH50Uk5TAAECAwQFBgcICQoLDg8QERITFBUWFxgZGhsgIiMmLC0vMzhAREdMT1hZWltcXV5fY2hpamtsbW5vcHN1d3uBgoSFhoeIiYqLjI2Oj5CRkpObnKOkp6iptbnAxMbHydDV1tfY2drb3OHi4+Tl5uzv8PHy8/T19vf4+fr7/P3+R4CtpQAAA6pJREFUeNrt2/dTE0EUwPFNIQdYSKJgQGPvvWMniFgp9hqagEhUDAqKKPe3m3a5TbL17u3bX3g/Xt6w3/lMZmEyhJDNCTe7O2ye3t4/7W4UhhLWAl65lXkTsXT+Tbc2t+ycnyx6AcWklYCcW5+cFYBlP8AKAQVghYAGsELQAGCBoBHAAsGg61olaAZAJ2gBQCZoBUAmYACgEqSWWQGIBBTAp68WCGiAc9csENz1z5yPteMTpH5QAITgEzQAlP4wxCZoAsAnaAJAJ2gBwCYYagZAJmAA4BIwAFAJ0iwATAImACIBDXCWfqEfiYADgEbABcAiuOefMhdrfAmFQACAQyAAQCFI/xQAYBAIAUoEXwwTSADMEwyLAYwTSAFME0gBDBPsoADO8JauGiTgAMT3742hELABnOFV113JJRAImADxfPXJu6hxAjbAbe/RDeME95nvgDnv2aTpdwEbYNuG9+xPm2ECCmDWB+jzn243S8C5A5gBRgjYAJwAB56AdwmyAwwQPGAD8ALACXau+D/wNJEHkCvABDwAboBTACXgAnADgAm4APwAUAI+AD8AlIAPIAgAJBAACAIACR7yAUQBYAQiAFEAGIEIQBgARCAEEAYAEVAAH2N6ASAE3RTAKaIXQC4DEDwSAkgCAAgkAJIAAAIaIKofEJqge1UMIAsITSADkAaEJJACSANCEkgB5AHOYggCOYA8IBTBYymAQkAIgh45gEJACAIFAJWAwAQ0wEkSPIBcCkhAAcxEwwQEJFACIKn6zl+HwBI8UQEgZMFbmubvBCJQA6DuqkHBUhACRQDSWfuQaMohoASqAISkX5d3XnQJl/QJVAHKkzl2tEeyktAlUAdQHF0CHQCl0STY9QsYQJdgBBpAk4AGOEGA5qIGgQGAEsFnZQIjADoEmgD7xvPvR7KABJoA19fKi6vn4QhG/b1pOcCh2uf16wegCDQBJrzdt1AEegDOmre8FIEh0ATori//64Ah0AMgpP4fAzMwd0FG9w6oB9+BuQt0AciW+er2pNKXTKQENMBxtQsu9bK8/Gyr2vYFCcGYLkB50kcOd6nuSggCAOiOmCAQgN4ICRAAxAQIAEKCzG8EABHBuP/KVNRcQGKBQ9CLA8AnQALgEqAB8AgoALf43ejQX43IsQAwp04w5loa73uKs7YCJqrnx9dtBWz0VgKyrrXpqwR0frN1/mLtPTBgK+BpLSD5wc75+U7vImgbXcI/vvCc/mUQyR5Enj1kc6rzH6X38cCnuOdNAAAAAElFTkSuQmCC"
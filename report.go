@@ -1,200 +1,390 @@
 package reportengine
 
 import (
+	"fmt"
 	"github.com/signintech/gopdf"
+	"io"
+	"os"
+	"time"
 )
 
 type Report struct {
-	pdf                      gopdf.GoPdf
-	rectangle                Rectangle
-	verticalComponentsMargin float64
+	pdf      gopdf.GoPdf
+	config   gopdf.Config
+	rendered bool
+	output   []byte //The rendered document, gopdf adds the pages again to a document written twice
 
-	headerFP   Component
-	headerCP   Component
-	headerLP   Component
-	footerFP   Component
-	footerCP   Component
-	footerLP   Component
-	contentsFP []Component
-	contentsCP []Component
-	contentsLP []Component
+	firstPage       *Section
+	continuousPages *Section
+	lastPage        *Section
+	sections        []*Section
 
-	pages []Page
+	pages            []Page
+	pageFieldReserve int
+	diagnostics      []Diagnostic
 }
 
 func NewReport(pageSize gopdf.Rect, marginLeft, marginRight, marginTop, marginBottom float64,
 	verticalComponentsMargin float64) *Report {
 	report := new(Report)
+	report.config = gopdf.Config{PageSize: pageSize}
 	report.pdf = gopdf.GoPdf{}
-	report.pdf.Start(gopdf.Config{PageSize: pageSize})
-	report.rectangle = NewRectangle(0, Solid, 0, White(), White(), true)
-	report.rectangle.lowerX = marginLeft
-	report.rectangle.lowerY = marginTop
-	report.rectangle.width = pageSize.W - marginLeft - marginRight
-	report.rectangle.height = pageSize.H - marginTop - marginBottom
-	report.verticalComponentsMargin = verticalComponentsMargin
+	report.pdf.Start(report.config)
+	report.firstPage = NewSection(pageSize, marginLeft, marginRight, marginTop, marginBottom, verticalComponentsMargin)
+	report.firstPage.splitType = SplitNormal
+	report.continuousPages = NewSection(pageSize, marginLeft, marginRight, marginTop, marginBottom, verticalComponentsMargin)
+	report.lastPage = NewSection(pageSize, marginLeft, marginRight, marginTop, marginBottom, verticalComponentsMargin)
+	report.lastPage.splitType = SplitNormal
+	report.pageFieldReserve = 999
 	return report
 }
 
+// Build lays out the pages. It can be called again, for example after a change of the contents:
+// the splittable contents are copied before they are split. A StreamGrid reads its rows once,
+// building again a report with a StreamGrid fails with ErrSourceConsumed.
 func (t *Report) Build() {
 	t.pages = make([]Page, 0)
-	if t.headerFP != nil || t.footerFP != nil || len(t.contentsFP) > 0 {
-		t.pages = append(t.pages, t.buildSinglePage(t.headerFP, t.footerFP, t.contentsFP))
+	t.diagnostics = make([]Diagnostic, 0)
+	sections := t.getSections()
+	pageSections := make([]*Section, 0) //Section of every page
+	//Also the pages laid out before a failure, with the headers and footers as they are built at the end
+	defer func() {
+		for i := range t.pages {
+			t.addDiagnostics(i+1, pageSections[i], t.pages[i])
+		}
+	}()
+	//Page fields are measured with the reserved value, the real ones are known only at the end
+	for _, section := range sections {
+		section.setPageContext(PageContext{Page: t.pageFieldReserve, Pages: t.pageFieldReserve,
+			SectionPage: t.pageFieldReserve, SectionPages: t.pageFieldReserve})
 	}
-	if t.headerCP != nil || t.footerCP != nil || len(t.contentsCP) > 0 {
-		t.pages = append(t.pages, t.buildMultiplePages(t.headerCP, t.footerCP, t.contentsCP)...)
+	pageNumbering := make([]int, 0)
+	restarts := make(map[int]int) //First page of a section with PageNumberingRestart -> first page number
+	for _, section := range sections {
+		first := len(t.pages)
+		t.buildPages(section, func(page Page) {
+			t.pages = append(t.pages, page)
+			pageSections = append(pageSections, section)
+			pageNumbering = append(pageNumbering, section.pageNumbering)
+		})
+		for i := first; i < len(t.pages); i++ {
+			t.pages[i].context.SectionPage = i - first + 1
+			t.pages[i].context.SectionPages = len(t.pages) - first
+		}
+		if section.pageNumbering == PageNumberingRestart {
+			restarts[first] = section.firstPageNumber
+		}
 	}
-	if t.headerLP != nil || t.footerLP != nil || len(t.contentsLP) > 0 {
-		t.pages = append(t.pages, t.buildSinglePage(t.headerLP, t.footerLP, t.contentsLP))
+	t.numberPages(pageNumbering, restarts)
+	if len(t.pages) > t.pageFieldReserve { //Headers and footers are measured again with the real number of pages
+		for _, section := range sections {
+			for _, c := range []Component{section.header, section.footer} {
+				setPageContext(c, PageContext{Page: len(t.pages), Pages: len(t.pages),
+					SectionPage: len(t.pages), SectionPages: len(t.pages)})
+			}
+			t.buildHeaderFooter(section)
+		}
 	}
 }
 
+// SafeBuild calls Build returning the error (a *ComponentError) instead of panicking
+func (t *Report) SafeBuild() (err error) {
+	defer recoverComponentError(nil, "Build", &err)
+	t.Build()
+	return nil
+}
+
+// SafeRender calls Render returning the error (a *ComponentError) instead of panicking
+func (t *Report) SafeRender() (err error) {
+	defer recoverComponentError(nil, "Render", &err)
+	t.Render()
+	return nil
+}
+
+// Render renders the pages in a new document, so it can be called again (for example after another Build)
 func (t *Report) Render() {
+	t.startPdf()
+	now := time.Now()
 	for i := range t.pages {
+		t.pages[i].context.Date = now
 		t.pages[i].Render(&t.pdf)
 	}
 }
 
+// Stream lays out the report and renders every page as soon as it is complete, writing the document to w
+// every few pages. The components are released once their page is rendered, so with a StreamGrid only the
+// rows of the current page are in memory, and the pages written are released too. The fonts are embedded
+// again in every part of the document written at once.
+// The total number of pages is not known while streaming: f{pages} and f{sectionPages} are empty.
+// The document is written only to w, Bytes and WriteTo don't return it.
+func (t *Report) Stream(w io.Writer) (err error) {
+	defer recoverComponentError(nil, "Build", &err)
+	t.startPdf()
+	t.diagnostics = make([]Diagnostic, 0)
+	out := newPdfStreamWriter(w)
+	chunkPages := 0
+	flush := func() error {
+		chunk, err := t.pdf.GetBytesPdfReturnErr()
+		if err != nil {
+			return err
+		}
+		t.pdf = gopdf.GoPdf{}
+		t.pdf.Start(t.config)
+		chunkPages = 0
+		return out.writeChunk(chunk)
+	}
+	now := time.Now()
+	number := 0
+	rendered := 0
+	for _, section := range t.getSections() {
+		section.setPageContext(PageContext{Page: t.pageFieldReserve, Pages: t.pageFieldReserve,
+			SectionPage: t.pageFieldReserve, SectionPages: t.pageFieldReserve})
+		if section.pageNumbering == PageNumberingRestart {
+			number = section.firstPageNumber - 1
+		}
+		sectionPage := 0
+		t.buildPages(section, func(page Page) {
+			sectionPage++
+			page.context = PageContext{SectionPage: sectionPage, Date: now}
+			if section.pageNumbering != PageNumberingNone {
+				number++
+				page.context.Page = number
+			}
+			page.Render(&t.pdf)
+			rendered++
+			chunkPages++
+			t.addDiagnostics(rendered, section, page)
+			if chunkPages == streamChunkPages {
+				if err := flush(); err != nil {
+					panic(newComponentError(nil, "Stream", err))
+				}
+			}
+		})
+	}
+	if chunkPages > 0 {
+		if err := flush(); err != nil {
+			return err
+		}
+	}
+	return out.close()
+}
+
+// Diagnostics returns the values changed to fit the layout by the last Build (or Stream): the texts
+// truncated or shrunk, the icons and fields dropped with them, the images replaced because not valid
+// and the content that doesn't fit an empty page (the Build fails).
+func (t *Report) Diagnostics() []Diagnostic {
+	return t.diagnostics
+}
+
+// Add the diagnostics of the header and footer of section and of the contents of page
+func (t *Report) addDiagnostics(number int, section *Section, page Page) {
+	diagnostics := append(childDiagnostics("header/", section.header), childDiagnostics("footer/", section.footer)...)
+	for _, d := range append(diagnostics, page.diagnostics...) {
+		d.Page = number
+		t.diagnostics = append(t.diagnostics, d)
+	}
+}
+
+// SetPageFieldReserve sets the number of pages used to measure the page fields (f{page}, f{pages}, ...)
+// before the real number is known. Headers and footers are measured again if the report has more pages.
+func (t *Report) SetPageFieldReserve(pages int) {
+	t.pageFieldReserve = pages
+}
+
+// AddSection appends a section to the report. The sections are laid out in order, after the first page
+// and the continuous pages and before the last page.
+func (t *Report) AddSection(section *Section) {
+	t.sections = append(t.sections, section)
+}
 func (t *Report) SetHeaderFP(header Component) {
-	t.headerFP = header
+	t.firstPage.SetHeader(header)
 }
 func (t *Report) SetHeaderCP(header Component) {
-	t.headerCP = header
+	t.continuousPages.SetHeader(header)
 }
 func (t *Report) SetHeaderLP(header Component) {
-	t.headerLP = header
+	t.lastPage.SetHeader(header)
 }
 func (t *Report) SetFooterFP(footer Component) {
-	t.footerFP = footer
+	t.firstPage.SetFooter(footer)
 }
 func (t *Report) SetFooterCP(footer Component) {
-	t.footerCP = footer
+	t.continuousPages.SetFooter(footer)
 }
 func (t *Report) SetFooterLP(footer Component) {
-	t.footerLP = footer
+	t.lastPage.SetFooter(footer)
 }
+
+// AddContentFP adds a content to the first page. The contents that don't fit in the first page
+// continue on the next pages, with the same header and footer.
 func (t *Report) AddContentFP(content Component) {
-	t.contentsFP = append(t.contentsFP, content)
+	t.firstPage.AddContent(content)
 }
 func (t *Report) AddContentCP(content Component) {
-	t.contentsCP = append(t.contentsCP, content)
+	t.continuousPages.AddContent(content)
 }
+
+// AddContentLP adds a content to the last page. The contents that don't fit in one page
+// continue on the next pages, with the same header and footer.
 func (t *Report) AddContentLP(content Component) {
-	t.contentsLP = append(t.contentsLP, content)
+	t.lastPage.AddContent(content)
 }
 
+// Deprecated: GetPdf returns a copy of the document, use WriteTo, Bytes or WriteFile
 func (t Report) GetPdf() gopdf.GoPdf {
 	return t.pdf
 }
 
-func (t *Report) buildMultiplePages(header, footer Component, contents []Component) []Page {
-	pages := make([]Page, 1)
-	if header != nil {
-		header.Build(&t.pdf, t.rectangle.width)
-		header.MoveTo(t.rectangle.lowerX, t.rectangle.lowerY)
+// WriteTo writes the document to w, the report is rendered if Render was not called
+func (t *Report) WriteTo(w io.Writer) (int64, error) {
+	output, err := t.getOutput()
+	if err != nil {
+		return 0, err
 	}
-	if footer != nil {
-		footer.Build(&t.pdf, t.rectangle.width)
-		footer.MoveTo(t.rectangle.lowerX, t.rectangle.lowerY+t.rectangle.height-footer.GetRectHeight())
+	n, err := w.Write(output)
+	return int64(n), err
+}
+
+// Bytes returns the document, the report is rendered if Render was not called
+func (t *Report) Bytes() ([]byte, error) {
+	output, err := t.getOutput()
+	if err != nil {
+		return nil, err
 	}
-	pages[0] = NewPage(t.rectangle, header, footer)
-	if footer != nil {
-		lowerX, lowerY, width, height := pages[0].getFirstVoidSpace()
-		if height >= t.verticalComponentsMargin/2.0 {
-			pages[0].content = append(pages[0].content, getFillComponent(lowerX, lowerY+height-t.verticalComponentsMargin/2.0,
-				width, t.verticalComponentsMargin/2.0))
-		}
+	return append([]byte{}, output...), nil
+}
+
+// WriteFile writes the document to the file path, the report is rendered if Render was not called
+func (t *Report) WriteFile(path string) error {
+	output, err := t.getOutput()
+	if err != nil {
+		return err
 	}
+	return os.WriteFile(path, output, 0644)
+}
 
-	for i := range contents {
-		contents[i].Build(&t.pdf, t.rectangle.width)
+// Return the document, rendered if Render was not called. It is compiled once, until the next Render.
+func (t *Report) getOutput() ([]byte, error) {
+	if t.output != nil {
+		return t.output, nil
 	}
-	index := 0
-	indexPage := 0
-	for i := 0; i < MaxIterationInfiniteLoop; i++ {
-		lowerX, lowerY, width, height := pages[indexPage].getFirstVoidSpace()
-		if index >= len(contents) {
-			break
-		}
-		if height <= 0 { //Page is full
-			pages = append(pages, NewPage(t.rectangle, header, footer))
-			indexPage++
-			continue
-		}
-		var topMargin = 0.0
-		if len(pages[indexPage].content) > 0 {
-			topMargin = t.verticalComponentsMargin / 2.0
+	if !t.rendered {
+		if err := t.SafeRender(); err != nil {
+			return nil, err
 		}
+	}
+	output, err := t.pdf.GetBytesPdfReturnErr()
+	if err != nil {
+		return nil, err
+	}
+	t.output = output
+	return output, nil
+}
 
-		if contents[index].GetRectHeight()+topMargin > height { //Too little space for render this component
-			if contents[index].IsSplittable() {
-				next := contents[index].Split(&t.pdf, height-topMargin, SplitRepeatFirstRow)
-				if next == nil { //Impossible split in this space, is too little
-					pages[indexPage].content = append(pages[indexPage].content, getFillComponent(lowerX, lowerY, width, height))
-					continue
-				}
-				contents = addSliceElement(contents, next, index+1)
-			} else {
-				pages[indexPage].content = append(pages[indexPage].content, getFillComponent(lowerX, lowerY, width, height))
-				continue
-			}
-		}
-		// Aggiunge spazio vuoto per Vertical Component Margin
-		if topMargin > 0.0 {
-			pages[indexPage].content = append(pages[indexPage].content, getFillComponent(lowerX, lowerY, width, topMargin))
-			lowerY += topMargin
+// Start a new document if the current one has already rendered pages. The fonts are loaded again when needed.
+func (t *Report) startPdf() {
+	t.output = nil
+	if t.rendered {
+		t.pdf = gopdf.GoPdf{}
+		t.pdf.Start(t.config)
+	}
+	t.rendered = true
+}
+
+func (t *Report) getSections() []*Section {
+	sections := make([]*Section, 0)
+	for _, section := range append(append([]*Section{t.firstPage, t.continuousPages}, t.sections...), t.lastPage) {
+		if !section.isEmpty() {
+			sections = append(sections, section)
 		}
-		contents[index].Adjust(&t.pdf, lowerX, lowerY, width, contents[index].GetRectHeight())
-		pages[indexPage].content = append(pages[indexPage].content, contents[index])
-		index++
 	}
-	return pages
+	return sections
 }
 
-func (t *Report) buildSinglePage(header, footer Component, contents []Component) Page {
-	if header != nil {
-		header.Build(&t.pdf, t.rectangle.width)
-		header.MoveTo(t.rectangle.lowerX, t.rectangle.lowerY)
+// Set page number and total pages of every page, pageNumbering[i] is the numbering of the section of page i
+func (t *Report) numberPages(pageNumbering []int, restarts map[int]int) {
+	number := 0
+	runStart := 0
+	for i := range t.pages {
+		if first, ok := restarts[i]; ok {
+			t.setTotalPages(runStart, i, number)
+			runStart = i
+			number = first - 1
+		}
+		if pageNumbering[i] == PageNumberingNone {
+			t.pages[i].context.Page = 0
+			continue
+		}
+		number++
+		t.pages[i].context.Page = number
 	}
-	if footer != nil {
-		footer.Build(&t.pdf, t.rectangle.width)
-		footer.MoveTo(t.rectangle.lowerX, t.rectangle.lowerY+t.rectangle.height-footer.GetRectHeight())
+	t.setTotalPages(runStart, len(t.pages), number)
+}
+func (t *Report) setTotalPages(from, to int, pages int) {
+	for i := from; i < to; i++ {
+		t.pages[i].context.Pages = pages
 	}
-	page := NewPage(t.rectangle, header, footer)
+}
+
+// Lay out the contents of the section, every page is passed to addPage as soon as it is complete
+func (t *Report) buildPages(section *Section, addPage func(page Page)) {
+	footer := section.footer
+	contents := append([]Component{}, section.contents...)
+	t.buildHeaderFooter(section)
+	page := newSectionPage(section)
 	if footer != nil {
 		lowerX, lowerY, width, height := page.getFirstVoidSpace()
-		if height >= t.verticalComponentsMargin/2.0 {
-			page.content = append(page.content, getFillComponent(lowerX, lowerY+height-t.verticalComponentsMargin/2.0,
-				width, t.verticalComponentsMargin/2.0))
+		if height >= section.verticalComponentsMargin/2.0 {
+			page.content = append(page.content, getFillComponent(lowerX, lowerY+height-section.verticalComponentsMargin/2.0,
+				width, section.verticalComponentsMargin/2.0))
 		}
 	}
 
 	for i := range contents {
-		contents[i].Build(&t.pdf, t.rectangle.width)
+		t.buildComponent(contents[i], section.rectangle.width)
 	}
 	index := 0
-	for i := 0; i < MaxIterationInfiniteLoop; i++ {
+	placed := false //A content is placed in the current page
+	for index < len(contents) {
 		lowerX, lowerY, width, height := page.getFirstVoidSpace()
-		if index >= len(contents) || height <= 0 { //Page is full
-			break
+		if height <= 0 { //Page is full
+			addPage(page)
+			page = newSectionPage(section)
+			placed = false
+			continue
 		}
 		var topMargin = 0.0
 		if len(page.content) > 0 {
-			topMargin = t.verticalComponentsMargin / 2.0
+			topMargin = section.verticalComponentsMargin / 2.0
+		}
+		if p, ok := contents[index].(prefetcher); ok { //Read only the contents needed to fill the page
+			t.prefetchComponent(p, height-topMargin)
 		}
 
+		var next Component
 		if contents[index].GetRectHeight()+topMargin > height { //Too little space for render this component
 			if contents[index].IsSplittable() {
-				next := contents[index].Split(&t.pdf, height-topMargin, SplitNormal)
-				if next == nil { //Impossible split in this space, is too little
-					page.content = append(page.content, getFillComponent(lowerX, lowerY, width, height))
-					continue
+				if contents[index] == section.contents[index] { //A copy is split, the content can be laid out again
+					contents[index] = cloneComponent(contents[index])
+				}
+				next = t.splitComponent(contents[index], height-topMargin, section.splitType)
+			}
+			if next == nil { //Impossible split in this space, is too little
+				if !placed { //Not even an empty page is enough
+					page.diagnostics = append(page.diagnostics, Diagnostic{Kind: DiagnosticComponentDropped,
+						Path:   fmt.Sprintf("contents[%d]/", index) + componentName(contents[index]),
+						Reason: fmt.Sprintf("%.2f needed, %.2f available", contents[index].GetRectHeight()+topMargin, height)})
+					addPage(page)
+					panic(newComponentError(section.contents[index], "Build", fmt.Errorf("%w: %.2f needed, %.2f available in an empty page",
+						ErrNoProgress, contents[index].GetRectHeight()+topMargin, height)))
 				}
-				contents = addSliceElement(contents, next, index+1)
-			} else {
 				page.content = append(page.content, getFillComponent(lowerX, lowerY, width, height))
 				continue
 			}
+			if contents[index].GetRectHeight()+topMargin > height {
+				panic(newComponentError(section.contents[index], "Split", fmt.Errorf("%w: first part of %.2f, %.2f available",
+					ErrNoProgress, contents[index].GetRectHeight()+topMargin, height)))
+			}
 		}
 		// Aggiunge spazio vuoto per Vertical Component Margin
 		if topMargin > 0.0 {
@@ -203,8 +393,64 @@ func (t *Report) buildSinglePage(header, footer Component, contents []Component)
 		}
 		contents[index].Adjust(&t.pdf, lowerX, lowerY, width, contents[index].GetRectHeight())
 		page.content = append(page.content, contents[index])
-		index++
+		page.diagnostics = append(page.diagnostics, childDiagnostics(fmt.Sprintf("contents[%d]/", index),
+			contents[index])...)
+		placed = true
+		if next != nil { //The rest of the component goes in the next space
+			contents[index] = next
+		} else {
+			contents[index] = nil
+			index++
+		}
+	}
+	addPage(page)
+}
+
+func (t *Report) buildHeaderFooter(section *Section) {
+	rect := section.rectangle
+	if section.header != nil {
+		t.buildComponent(section.header, rect.width)
+		section.header.MoveTo(rect.lowerX, rect.lowerY)
+	}
+	if section.footer != nil {
+		t.buildComponent(section.footer, rect.width)
+		section.footer.MoveTo(rect.lowerX, rect.lowerY+rect.height-section.footer.GetRectHeight())
+	}
+}
+
+// Build the component, the panic identifies it if it comes from a lower level (fonts, ...)
+func (t *Report) buildComponent(component Component, maxWidth float64) {
+	err := SafeBuild(component, &t.pdf, maxWidth)
+	if err != nil {
+		panic(asComponentError(component, "Build", err))
+	}
+}
+
+// Split the component, the panic identifies it if it comes from a lower level
+func (t *Report) splitComponent(component Component, firstHeight float64, splitType int) Component {
+	next, err := SafeSplit(component, &t.pdf, firstHeight, splitType)
+	if err != nil {
+		panic(asComponentError(component, "Split", err))
+	}
+	return next
+}
+
+// Prefetch the component, the panic identifies it if it comes from a lower level
+func (t *Report) prefetchComponent(component prefetcher, height float64) {
+	err := safePrefetch(component, &t.pdf, height)
+	if err != nil {
+		panic(asComponentError(component, "Build", err))
 	}
+}
+func safePrefetch(component prefetcher, pdf *gopdf.GoPdf, height float64) (err error) {
+	defer recoverComponentError(component, "Build", &err)
+	component.prefetch(pdf, height)
+	return nil
+}
+
+func newSectionPage(section *Section) Page {
+	page := NewPage(section.rectangle, section.header, section.footer)
+	page.pageSize = section.pageSize
 	return page
 }
 
@@ -216,15 +462,3 @@ func getFillComponent(lowerX, lowerY, width, height float64) Component {
 	rect.height = height
 	return NewCellText(gopdf.Center, gopdf.Middle, "", false, IconFontFamily, 1, White(), NewMargin(0.0), rect)
 }
-
-func addSliceElement(contents []Component, element Component, index int) []Component {
-	temp := make([]Component, len(contents)+1)
-	for i := 0; i < index; i++ {
-		temp[i] = contents[i]
-	}
-	temp[index] = element
-	for i := index + 1; i < len(contents)+1; i++ {
-		temp[i] = contents[i-1]
-	}
-	return temp
-}
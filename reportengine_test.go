@@ -1,13 +1,28 @@
 package reportengine
 
 import (
+	"bytes"
+	"errors"
+	"fmt"
 	"github.com/signintech/gopdf"
 	"log"
+	"math"
+	"os"
+	"regexp"
+	"runtime"
+	"strconv"
+	"strings"
 	"testing"
 )
 
 const testOutputDirectory = "testOutput/"
 
+func init() {
+	if err := Fonts.RegisterDir("fonts"); err != nil {
+		panic(err)
+	}
+}
+
 func TestCellTextArea(t *testing.T) {
 	pdf := &gopdf.GoPdf{}
 	pdf.Start(gopdf.Config{PageSize: *gopdf.PageSizeA4})
@@ -67,11 +82,1500 @@ func TestGrid(t *testing.T) {
 	grid.Adjust(pdf, 50, 100, grid.MinWidth(pdf), grid.MinHeight())
 	grid.MoveTo(5, 5)
 	grid.Render(pdf)
+
+	//The same grid split twice, outside a report: the rows given to the grid are not changed
+	table := getTable(30, 3).(*Grid)
+	m := table.matrix
+	rows := append([][]Component{}, m...)
+	table.Build(pdf, 300)
+	next := table.Split(pdf, table.MinHeight()/2, SplitRepeatFirstRow).(*Grid)
+	first := len(table.matrix)
+	again := table.Split(pdf, table.MinHeight()/2, SplitRepeatFirstRow).(*Grid)
+	for i := range rows {
+		if m[i][0] != rows[i][0] {
+			t.Fatalf("row %d changed by the split", i)
+		}
+	}
+	if next.matrix[0][0] != rows[0][0] || next.matrix[1][0] != rows[first][0] || again.matrix[0][0] != rows[0][0] ||
+		again.matrix[1][0] != rows[len(table.matrix)][0] || len(table.matrix)+len(again.matrix)+len(next.matrix)-2 != len(rows) {
+		t.Errorf("wrong parts: %d, %d and %d rows of %d", len(table.matrix), len(again.matrix), len(next.matrix), len(rows))
+	}
 	err := pdf.WritePdf(testOutputDirectory + "TestGrid.pdf")
 	if err != nil {
 		panic(err)
 	}
 }
+func TestGridColumns(t *testing.T) {
+	pdf := &gopdf.GoPdf{}
+	pdf.Start(gopdf.Config{PageSize: *gopdf.PageSizeA4})
+	pdf.AddPage()
+	grid := getTable(5, 4).(*Grid)
+	grid.SetColumns(NewAutoColumn(), NewFixedColumn(100), NewPercentageColumn(20), NewWeightColumn(1))
+	grid.Build(pdf, gopdf.PageSizeA4.W-20)
+	grid.Adjust(pdf, 10, 10, grid.MinWidth(pdf), grid.MinHeight())
+	grid.Render(pdf)
+	x0, _ := grid.matrix[1][0].GetRectPosition()
+	x1, _ := grid.matrix[1][1].GetRectPosition()
+	if grid.matrix[1][1].GetRectWidth() != 100 || x1-x0 != grid.matrix[1][0].GetRectWidth() {
+		t.Error("fixed column not respected")
+	}
+	err := pdf.WritePdf(testOutputDirectory + "TestGridColumns.pdf")
+	if err != nil {
+		panic(err)
+	}
+	grid.SetColumns(NewFixedColumn(400), NewFixedColumn(400), NewAutoColumn(), NewAutoColumn())
+	defer func() {
+		if recover() == nil {
+			t.Error("expected overflow of fixed columns")
+		}
+	}()
+	grid.Build(pdf, gopdf.PageSizeA4.W-20)
+}
+func TestGridSpan(t *testing.T) {
+	report := NewReport(*gopdf.PageSizeA4, 10, 10, 10, 10,
+		20)
+	report.AddContentCP(getTableWithSpans(60))
+	report.Build()
+	for _, page := range report.pages {
+		for _, c := range page.content {
+			if grid, ok := c.(*Grid); ok && len(grid.matrix)%3 != 2 {
+				t.Errorf("grid split inside a row span (%d rows)", len(grid.matrix))
+			}
+		}
+	}
+	report.Render()
+	err := report.WriteFile(testOutputDirectory + "TestGridSpan.pdf")
+	if err != nil {
+		log.Print(err.Error())
+		return
+	}
+}
+func TestCellTextAreaSplit(t *testing.T) {
+	report := NewReport(*gopdf.PageSizeA5, 10, 10, 10, 10,
+		20)
+	text := ""
+	for i := 0; i < 600; i++ {
+		text += randStringRunes(6) + " "
+	}
+	cta := NewCellTextArea(gopdf.Left, gopdf.Top, text, false, "Arial-Regular", 12, Black(), NewMargin(2),
+		NewRectangle(gopdf.AllBorders, Solid, 1, White(), Black(), true))
+	cta.SetOrphans(2)
+	cta.SetWidows(3)
+	report.SetHeaderCP(getGrid())
+	report.AddContentCP(getTable(3, 3))
+	report.AddContentCP(cta)
+	report.Build()
+	words, parts, last := 0, 0, 0
+	for _, page := range report.pages {
+		for _, c := range page.content {
+			if v, ok := c.(*CellTextArea); ok && v.fontFamily == "Arial-Regular" {
+				words += len(v.cellsText)
+				parts++
+				last = len(v.cellsTextMerged)
+				if parts == 1 && last < 2 {
+					t.Errorf("orphans not respected (%d lines on the first page)", last)
+				}
+			}
+		}
+	}
+	if parts < 2 || last < 3 || words != len(strings.Fields(text)) {
+		t.Errorf("text area split in %d parts with %d words (%d lines on the last page)", parts, words, last)
+	}
+	//Split with room for the given lines of a 10 lines paragraph
+	pdf := &gopdf.GoPdf{}
+	pdf.Start(gopdf.Config{PageSize: *gopdf.PageSizeA5})
+	pdf.AddPage()
+	split := func(orphans, widows, room int) (first, next int) {
+		area := NewCellTextArea(gopdf.Left, gopdf.Top, strings.Repeat("parola ", 20), false, "Arial-Regular", 12, Black(), NewMargin(2),
+			NewRectangle(gopdf.AllBorders, Solid, 1, White(), Black(), true))
+		area.SetOrphans(orphans)
+		area.SetWidows(widows)
+		area.Build(pdf, 100)
+		if len(area.cellsTextMerged) != 10 {
+			t.Fatalf("expected 10 lines, got %d", len(area.cellsTextMerged))
+		}
+		height := area.minMarginText.top + area.minMarginText.bottom
+		for i := 0; i < room; i++ {
+			height += area.cellsTextMerged[i].MinHeight()
+		}
+		height += area.cellsTextMerged[room].MinHeight() / 2
+		rest := area.Split(pdf, height, 0)
+		if rest == nil {
+			return len(area.cellsTextMerged), 0
+		}
+		return len(area.cellsTextMerged), len(rest.(*CellTextArea).cellsTextMerged)
+	}
+	for _, v := range []struct{ orphans, widows, room, first, next int }{
+		{2, 3, 5, 5, 5},
+		{2, 3, 2, 2, 8},  //Orphans: the first page keeps 2 lines
+		{2, 3, 8, 7, 3},  //Widows: 3 lines move to the next page
+		{2, 3, 1, 10, 0}, //Too few lines for the orphans: all to the next page
+		{5, 6, 6, 10, 0}, //The widows leave too few lines for the orphans: all to the next page
+	} {
+		first, next := split(v.orphans, v.widows, v.room)
+		if first != v.first || next != v.next {
+			t.Errorf("orphans %d, widows %d, room for %d lines: split %d+%d lines, expected %d+%d",
+				v.orphans, v.widows, v.room, first, next, v.first, v.next)
+		}
+	}
+	report.Render()
+	err := report.WriteFile(testOutputDirectory + "TestCellTextAreaSplit.pdf")
+	if err != nil {
+		log.Print(err.Error())
+		return
+	}
+}
+func TestPageFields(t *testing.T) {
+	report := NewReport(*gopdf.PageSizeA4, 10, 10, 10, 10,
+		20)
+	footer := NewCellText(gopdf.Right, gopdf.Middle, "Pagina f{page} di f{pages} - f{date;02/01/2006 15:04}",
+		false, "Arial-Regular", 10, Black(), NewMargin(2), NewRectangle(gopdf.AllBorders, Solid, 1, White(), Black(), true))
+	report.SetHeaderCP(getGrid())
+	report.AddContentCP(getTable(120, 5))
+	report.SetFooterCP(footer)
+	report.Build()
+	if len(footer.tokens) != 6 || footer.tokens[1].field != FieldPage || footer.tokens[3].field != FieldPages {
+		t.Fatal("page fields not parsed")
+	}
+	last := report.pages[len(report.pages)-1]
+	footer.SetPageContext(last.context)
+	if footer.tokens[1].text() != strconv.Itoa(len(report.pages)) || last.context.SectionPage != len(report.pages) {
+		t.Errorf("wrong page context %+v", last.context)
+	}
+	//A component without SetPageContext, like the ones written before the page fields
+	report.AddContentCP(struct{ Component }{getCellTextAreaStr("Senza campi")})
+	if err := report.SafeBuild(); err != nil {
+		t.Fatal(err)
+	}
+	report.Render()
+	err := report.WriteFile(testOutputDirectory + "TestPageFields.pdf")
+	if err != nil {
+		log.Print(err.Error())
+		return
+	}
+}
+func TestSections(t *testing.T) {
+	report := NewReport(*gopdf.PageSizeA4, 10, 10, 10, 10,
+		20)
+	pageField := func() Component {
+		return NewCellText(gopdf.Right, gopdf.Middle, "f{page}/f{pages} (f{sectionPage}/f{sectionPages})",
+			false, "Arial-Regular", 10, Black(), NewMargin(2), NewRectangle(0, Solid, 1, White(), Black(), true))
+	}
+	cover := NewSection(*gopdf.PageSizeA4, 50, 50, 50, 50, 20)
+	cover.AddContent(getCellTextAreaStr("Cover"))
+	cover.SetPageNumbering(PageNumberingNone, 0)
+	body := NewSection(*gopdf.PageSizeA4, 10, 10, 10, 10, 20)
+	body.SetFooter(pageField())
+	body.AddContent(getTable(80, 5))
+	body.SetPageNumbering(PageNumberingRestart, 1)
+	appendix := NewSection(*gopdf.PageSizeA4Landscape, 10, 10, 10, 10, 20)
+	appendix.SetHeader(getGrid())
+	appendix.SetFooter(pageField())
+	appendix.AddContent(getTable(30, 8))
+	terms := NewSection(*gopdf.PageSizeA5, 10, 10, 10, 10, 20)
+	terms.SetFooter(pageField())
+	terms.AddContent(getCellTextArea())
+	for _, section := range []*Section{cover, body, appendix, terms} {
+		report.AddSection(section)
+	}
+	report.Build()
+	first, last := report.pages[0], report.pages[len(report.pages)-1]
+	if first.context.Page != 0 || last.context.Page != len(report.pages)-1 || last.context.Pages != len(report.pages)-1 {
+		t.Errorf("wrong page numbering: first %+v last %+v", first.context, last.context)
+	}
+	if last.pageSize != *gopdf.PageSizeA5 || last.context.SectionPages != 1 {
+		t.Errorf("wrong last section %+v", last.context)
+	}
+	report.Render()
+	err := report.WriteFile(testOutputDirectory + "TestSections.pdf")
+	if err != nil {
+		log.Print(err.Error())
+		return
+	}
+}
+func TestFirstPageOverflow(t *testing.T) {
+	report := NewReport(*gopdf.PageSizeA4, 10, 10, 10, 10,
+		20)
+	report.SetHeaderFP(getGrid())
+	report.AddContentFP(getTable(70, 3))
+	report.AddContentFP(getCellTextArea())
+	report.SetFooterFP(getGrid())
+	report.Build()
+	rows, textAreas := 0, 0
+	for _, page := range report.pages {
+		for _, c := range page.content {
+			if c == report.firstPage.header || c == report.firstPage.footer {
+				continue
+			}
+			switch v := c.(type) {
+			case *Grid:
+				rows += len(v.matrix)
+			case *CellTextArea:
+				textAreas++
+			}
+		}
+	}
+	if len(report.pages) < 2 || rows != 70 || textAreas != 1 {
+		t.Errorf("first page contents lost: %d pages, %d rows, %d text areas", len(report.pages), rows, textAreas)
+	}
+	report.Render()
+	err := report.WriteFile(testOutputDirectory + "TestFirstPageOverflow.pdf")
+	if err != nil {
+		log.Print(err.Error())
+		return
+	}
+}
+
+// A component that panics with value when it is built or rendered
+type panicComponent struct {
+	CellText
+	value interface{}
+}
+
+func (t *panicComponent) Build(*gopdf.GoPdf, float64) {
+	panic(t.value)
+}
+func (t *panicComponent) Render(*gopdf.GoPdf) {
+	panic(t.value)
+}
+func TestErrors(t *testing.T) {
+	var ce *ComponentError
+	pdf := &gopdf.GoPdf{}
+	pdf.Start(gopdf.Config{PageSize: *gopdf.PageSizeA4})
+	cell := getCellTextAreaStr("Text")
+	err := SafeBuild(cell, pdf, 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = SafeAdjust(cell, pdf, 0, 0, 1, 1)
+	if !errors.Is(err, ErrInsufficientSpace) || !errors.As(err, &ce) || ce.Component != cell {
+		t.Errorf("wrong adjust error: %v", err)
+	}
+	grid := getTable(3, 2).(*Grid)
+	grid.SetColumns(NewFixedColumn(300), NewFixedColumn(300))
+	err = SafeBuild(grid, pdf, 500)
+	if !errors.Is(err, ErrColumnsOverflow) {
+		t.Errorf("wrong build error: %v", err)
+	}
+	report := NewReport(*gopdf.PageSizeA4, 10, 10, 10, 10,
+		20)
+	content := NewCellText(gopdf.Left, gopdf.Top, "Text", false, "NotExisting-Regular", 10, Black(), NewMargin(2),
+		NewRectangle(0, Solid, 1, White(), Black(), true))
+	report.AddContentCP(content)
+	err = report.SafeBuild()
+	if !errors.Is(err, ErrFontNotFound) || !errors.As(err, &ce) || ce.Component != content {
+		t.Errorf("wrong report error: %v", err)
+	}
+	if err = SafeLoadFont(pdf, "NotExisting-Regular"); !errors.Is(err, ErrFontNotFound) {
+		t.Errorf("wrong font error: %v", err)
+	}
+	//The errors of the library go through the Safe functions, the other panics are raised again
+	text := *NewCellText(gopdf.Left, gopdf.Top, "Text", false, "Arial-Regular", 10, Black(), NewMargin(2),
+		NewRectangle(0, Solid, 1, White(), Black(), true))
+	for _, sentinel := range []error{ErrInsufficientWidth, ErrInsufficientSpace, ErrColumnsOverflow, ErrFontNotFound,
+		ErrInvalidImage, ErrNoProgress, ErrTextOverflow, ErrSourceConsumed} {
+		c := panicComponent{CellText: text, value: newComponentError(nil, "Test",
+			fmt.Errorf("%w: test", sentinel))}
+		buildErr, renderErr := SafeBuild(&c, pdf, 100), SafeRender(&c, pdf)
+		if !errors.Is(buildErr, sentinel) || !errors.Is(renderErr, sentinel) || !errors.As(renderErr, &ce) || ce.Component != &c {
+			t.Errorf("%v not returned: %v, %v", sentinel, buildErr, renderErr)
+		}
+	}
+	for _, value := range []interface{}{errors.New("not of the library"), ErrNoProgress, "Not implemented"} {
+		func() {
+			defer func() {
+				if recover() != value {
+					t.Errorf("%v not raised again", value)
+				}
+			}()
+			err := SafeRender(&panicComponent{CellText: text, value: value}, pdf)
+			t.Errorf("error returned: %v", err)
+		}()
+	}
+	//A bug is not turned into an error
+	defer func() {
+		if _, ok := recover().(runtime.Error); !ok {
+			t.Errorf("runtime error not raised again")
+		}
+	}()
+	grid = NewGrid([][]Component{{getCellTextAreaStr("Text"), nil}},
+		NewRectangle(0, Solid, 0, White(), Black(), true), NewMargin(0), gopdf.Left, gopdf.Top)
+	err = SafeBuild(grid, pdf, 500)
+	t.Errorf("error returned: %v", err)
+}
+func TestLargeReport(t *testing.T) {
+	nRow := 20000
+	m := make([][]Component, nRow)
+	for i := range m {
+		m[i] = []Component{NewCellText(gopdf.Left, gopdf.Top, strconv.Itoa(i), false, "ArchitectsDaughter-Regular", 8,
+			Black(), NewMargin(1), NewRectangle(0, Solid, 0, White(), Black(), true))}
+	}
+	report := NewReport(*gopdf.PageSizeA4, 10, 10, 10, 10,
+		20)
+	report.AddContentCP(NewGrid(m, NewRectangle(0, Solid, 0, White(), Black(), true), NewMargin(0),
+		gopdf.Left, gopdf.Top))
+	if err := report.SafeBuild(); err != nil {
+		t.Fatal(err)
+	}
+	rows := 0
+	for _, page := range report.pages {
+		for _, c := range page.content {
+			if grid, ok := c.(*Grid); ok {
+				//Every page repeats the first row and continues from the rows of the previous one
+				if grid.matrix[0][0] != m[0][0] || grid.matrix[1][0] != m[rows+1][0] ||
+					math.Abs(grid.MinHeight()-grid.sumRowHeights(0, len(grid.rowHeights))) > 1e-6 {
+					t.Fatalf("wrong rows after row %d", rows)
+				}
+				rows += len(grid.matrix) - 1
+			}
+		}
+	}
+	if rows+1 != nRow {
+		t.Errorf("rows lost: %d rows in %d pages", rows+1, len(report.pages))
+	}
+
+	var ce *ComponentError
+	report = NewReport(*gopdf.PageSizeA4, 10, 10, 10, 10,
+		20)
+	//A single row is not splittable, and it is taller than an empty page
+	content := NewGrid([][]Component{{getCellTextAreaStr(strings.Repeat("Text ", 3000))}},
+		NewRectangle(0, Solid, 0, White(), Black(), true), NewMargin(0), gopdf.Left, gopdf.Top)
+	report.AddContentCP(content)
+	err := report.SafeBuild()
+	if !errors.Is(err, ErrNoProgress) || !errors.As(err, &ce) || ce.Component != content {
+		t.Errorf("wrong no progress error: %v", err)
+	}
+}
+func TestStreamGrid(t *testing.T) {
+	nRow := 5000
+	getRow := func(i int) []Component {
+		return []Component{getCellTextAreaStr(strconv.Itoa(i)), NewSpanCell(getCellTextAreaStr("Row"), 1, 2)}
+	}
+	header := [][]Component{{getCellTextAreaStr("#"), getCellTextAreaStr("A"), getCellTextAreaStr("B")}}
+	streamed := make([][]Component, nRow)
+	for i := range streamed {
+		streamed[i] = getRow(i)
+	}
+	rows := make(chan []Component)
+	go func() {
+		for _, row := range streamed {
+			rows <- row
+		}
+		close(rows)
+	}()
+	report := NewReport(*gopdf.PageSizeA4, 10, 10, 10, 10,
+		20)
+	footer := func() Component {
+		return NewCellText(gopdf.Center, gopdf.Middle, "Pagina f{page}", false, "ArchitectsDaughter-Regular", 10,
+			Black(), NewMargin(2), NewRectangle(0, Solid, 1, White(), Black(), true))
+	}
+	report.SetFooterCP(footer())
+	var buf bytes.Buffer
+	source, read, written := NewChannelRowSource(rows), 0, 0
+	report.AddContentCP(NewStreamGrid(header, func() ([]Component, bool) {
+		if read++; read == nRow { //Bytes written before the last row is read
+			written = buf.Len()
+		}
+		return source()
+	}, NewRectangle(gopdf.AllBorders, Solid, 1.1, White(), Black(), true), NewMargin(5), gopdf.Center, gopdf.Top))
+	if err := report.Stream(&buf); err != nil {
+		t.Fatal(err)
+	}
+	pages := len(regexp.MustCompile(`/Type /Page\s`).FindAll(buf.Bytes(), -1))
+	if !bytes.HasPrefix(buf.Bytes(), []byte("%PDF")) || !bytes.HasSuffix(bytes.TrimSpace(buf.Bytes()), []byte("%%EOF")) ||
+		len(report.pages) != 0 || pages <= streamChunkPages || written == 0 {
+		t.Errorf("report not streamed: %d pages, %d bytes written before the last row", pages, written)
+	}
+	//The pages of all the parts are in the page tree of the document
+	if !bytes.Contains(buf.Bytes(), []byte(fmt.Sprintf("1 0 obj\n<<\n  /Type /Pages\n  /Count %d\n", pages))) {
+		t.Errorf("page tree without %d pages", pages)
+	}
+	if err := os.WriteFile(testOutputDirectory+"TestStreamGrid.pdf", buf.Bytes(), 0644); err != nil {
+		t.Fatal(err)
+	}
+	//Every row is rendered once, in order: its cells have the context of their page
+	page := 1
+	for i, row := range streamed {
+		p := row[0].(*CellTextArea).cellsText[0].pageContext.Page
+		if p < page || p > page+1 {
+			t.Fatalf("row %d rendered in page %d after page %d", i, p, page)
+		}
+		page = p
+	}
+	if page != pages || pages < 2 {
+		t.Errorf("%d pages written, last row in page %d", pages, page)
+	}
+
+	//Without streaming all the rows are kept, every page repeats the header
+	i := 0
+	report = NewReport(*gopdf.PageSizeA4, 10, 10, 10, 10,
+		20)
+	report.SetFooterCP(footer())
+	report.AddContentCP(NewStreamGrid(header, func() ([]Component, bool) {
+		if i >= nRow {
+			return nil, false
+		}
+		i++
+		return getRow(i - 1), true
+	}, NewRectangle(gopdf.AllBorders, Solid, 1.1, White(), Black(), true), NewMargin(5), gopdf.Center, gopdf.Top))
+	if err := report.SafeBuild(); err != nil {
+		t.Fatal(err)
+	}
+	count := 0
+	for _, page := range report.pages {
+		for _, c := range page.content {
+			if grid, ok := c.(*StreamGrid); ok {
+				count += len(grid.matrix) - 1
+			}
+		}
+	}
+	if count != nRow || len(report.pages) != pages {
+		t.Errorf("rows lost: %d rows in %d pages, %d pages streamed", count, len(report.pages), pages)
+	}
+	if err := report.SafeBuild(); !errors.Is(err, ErrSourceConsumed) {
+		t.Errorf("rows read twice: %v", err)
+	}
+}
+func TestReportOutput(t *testing.T) {
+	report := NewReport(*gopdf.PageSizeA4, 10, 10, 10, 10,
+		20)
+	report.SetHeaderCP(getGrid())
+	report.AddContentCP(getTable(60, 5))
+	report.Build()
+	report.Render()
+	pages := report.pdf.GetNumberOfPages()
+	report.Render()
+	if report.pdf.GetNumberOfPages() != pages || pages != len(report.pages) {
+		t.Errorf("pages rendered again: %d pages, %d expected", report.pdf.GetNumberOfPages(), len(report.pages))
+	}
+	data, err := report.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	n, err := report.WriteTo(&buf)
+	if err != nil || n != int64(buf.Len()) || !bytes.HasPrefix(data, []byte("%PDF")) || buf.Len() == 0 {
+		t.Errorf("wrong output: %d bytes, %d written, %v", len(data), n, err)
+	}
+	//Written again, the document has the same pages
+	if !bytes.Equal(data, buf.Bytes()) || !bytes.Contains(data, []byte(fmt.Sprintf("/Count %d\n", pages))) {
+		t.Errorf("pages written twice")
+	}
+	err = report.WriteFile(testOutputDirectory + "TestReportOutput.pdf")
+	if err != nil {
+		t.Error(err)
+	}
+
+	//Built again, the contents split in the pages are laid out from the start
+	report = NewReport(*gopdf.PageSizeA5, 10, 10, 10, 10,
+		20)
+	report.AddContentCP(getTable(103, 3))
+	report.AddContentCP(getCellTextAreaStr(strings.Repeat("Testo che continua nella pagina successiva. ", 60)))
+	count := func() (rows, lines int) {
+		for _, page := range report.pages {
+			for _, c := range page.content {
+				switch v := c.(type) {
+				case *Grid:
+					rows += len(v.matrix) - 1 //The header is repeated
+				case *CellTextArea:
+					lines += len(v.cellsTextMerged)
+				}
+			}
+		}
+		return rows, lines
+	}
+	report.Build()
+	pages = len(report.pages)
+	rows, lines := count()
+	if rows != 102 || pages < 4 {
+		t.Errorf("first build: %d rows in %d pages", rows, pages)
+	}
+	report.Build()
+	if r, l := count(); len(report.pages) != pages || r != rows || l != lines {
+		t.Errorf("second build: %d rows and %d lines in %d pages, %d rows and %d lines in %d pages expected",
+			r, l, len(report.pages), rows, lines, pages)
+	}
+	report.Render()
+	if report.pdf.GetNumberOfPages() != pages {
+		t.Errorf("%d pages rendered, %d expected", report.pdf.GetNumberOfPages(), pages)
+	}
+}
+func TestFontRegistry(t *testing.T) {
+	registry := NewFontRegistry()
+	err := registry.RegisterFS(os.DirFS("fonts"), "googleFonts/Oswald")
+	if err != nil || !registry.Has("Oswald-Bold") || registry.Has("ArchitectsDaughter-Regular") {
+		t.Errorf("wrong families: %v, %v", registry.Families(), err)
+	}
+	data, err := os.ReadFile("fonts/Arial-Regular.ttf")
+	if err != nil {
+		t.Fatal(err)
+	}
+	registry.RegisterBytes("Custom", data)
+	if err = registry.RegisterFile("Missing", "fonts/Missing.ttf"); !errors.Is(err, ErrFontNotFound) {
+		t.Errorf("wrong register error: %v", err)
+	}
+	pdf := &gopdf.GoPdf{}
+	pdf.Start(gopdf.Config{PageSize: *gopdf.PageSizeA4})
+	for _, family := range []string{"Oswald-Bold", "Custom"} {
+		if err = registry.load(pdf, family); err != nil {
+			t.Errorf("%s not loaded: %v", family, err)
+		}
+	}
+	if registry.load(pdf, "Missing") == nil {
+		t.Errorf("not registered font loaded")
+	}
+	//The icons are always registered
+	if err = NewFontRegistry().load(pdf, IconFontFamily); err != nil {
+		t.Errorf("icons not loaded: %v", err)
+	}
+	//The default registry falls back to the bundled fonts, without replacing the registered ones
+	fallback := newDefaultFontRegistry()
+	fallback.RegisterBytes("Arial-Regular", data[:10])
+	if font, err := fallback.Resolve("Roboto", FontWeightBold, false); err != nil || font != "Roboto-Bold" {
+		t.Errorf("bundled font not found: %s, %v", font, err)
+	}
+	if arial, err := fallback.read("Arial-Regular"); err != nil || len(arial) != 10 || fallback.Has("Missing") {
+		t.Errorf("registered font replaced: %v", err)
+	}
+}
+func TestFontStyles(t *testing.T) {
+	cases := []struct {
+		family string
+		weight int
+		italic bool
+		font   string
+	}{
+		{"ArchitectsDaughter-Regular", 0, false, "ArchitectsDaughter-Regular"},
+		{"Roboto", FontWeightBold, true, "Roboto-BoldItalic"},
+		{"Roboto", FontWeightSemiBold, false, "Roboto-Bold"},
+		{"Rubik-Regular", FontWeightBold, false, "Rubik-Bold"},
+		{"Oswald", FontWeightBold, true, "Oswald-Bold"},
+		{"Comfortaa", FontWeightBlack, false, "Comfortaa-Bold"},
+		{"Comfortaa", 450, false, "Comfortaa-Medium"},
+		{"IBM Plex Sans", 0, true, "IBMPlexSans-Italic"},
+	}
+	for _, c := range cases {
+		font, err := Fonts.Resolve(c.family, c.weight, c.italic)
+		if err != nil || font != c.font {
+			t.Errorf("%s %d %v: %s expected, %s found (%v)", c.family, c.weight, c.italic, c.font, font, err)
+		}
+	}
+	if _, err := Fonts.Resolve("NotExisting", FontWeightBold, false); !errors.Is(err, ErrFontNotFound) {
+		t.Errorf("wrong resolve error: %v", err)
+	}
+
+	pdf := &gopdf.GoPdf{}
+	pdf.Start(gopdf.Config{PageSize: *gopdf.PageSizeA4})
+	pdf.AddPage()
+	text := NewCellText(gopdf.Left, gopdf.Top, "Roboto bold italic i{0xF0A43;#0000FF}", false, "Roboto", 14, Black(),
+		NewMargin(2), NewRectangle(0, Solid, 1, White(), Black(), true))
+	text.SetFontWeight(FontWeightBold)
+	text.SetItalic(true)
+	if text.tokens[0].fontFamily != "Roboto-BoldItalic" || text.tokens[1].fontFamily != IconFontFamily {
+		t.Errorf("wrong token fonts: %s, %s", text.tokens[0].fontFamily, text.tokens[1].fontFamily)
+	}
+	area := NewCellTextArea(gopdf.Left, gopdf.Top, "Montserrat light, the nearest weight of the family", false,
+		"Montserrat", 14, Black(), NewMargin(2), NewRectangle(0, Solid, 1, White(), Black(), true))
+	area.SetFontWeight(FontWeightLight)
+	grid := NewGrid([][]Component{{text}, {area}}, NewRectangle(0, Solid, 1, White(), Black(), true), NewMargin(5),
+		gopdf.Left, gopdf.Top)
+	grid.Build(pdf, 200)
+	grid.Adjust(pdf, 10, 10, 200, grid.MinHeight())
+	grid.Render(pdf)
+	err := pdf.WritePdf(testOutputDirectory + "TestFontStyles.pdf")
+	if err != nil {
+		log.Print(err.Error())
+		return
+	}
+}
+func TestMarkup(t *testing.T) {
+	style := textStyle{fontFamily: "Roboto", fontSize: 10, color: Black()}
+	tokens := parseMarkup(`Total: **1.200 €** \*\*a\{b\}\\ c{#FF0000;red s{20;__big__} i{0xF0A43;#0000FF}} c{x;y}`,
+		style, true)
+	expected := []Token{
+		{value: "Total: ", fontFamily: "Roboto-Regular", fontSize: 10, color: Black()},
+		{value: "1.200 €", fontFamily: "Roboto-Bold", fontSize: 10, color: Black()},
+		{value: ` **a{b}\ `, fontFamily: "Roboto-Regular", fontSize: 10, color: Black()},
+		{value: "red ", fontFamily: "Roboto-Regular", fontSize: 10, color: Red()},
+		{value: "big", fontFamily: "Roboto-Italic", fontSize: 20, color: Red()},
+		{value: " ", fontFamily: "Roboto-Regular", fontSize: 10, color: Red()},
+		{value: "\U000F0A43", fontFamily: IconFontFamily, fontSize: 10, color: Color{0, 0, 255}},
+		{value: " c{x;y}", fontFamily: "Roboto-Regular", fontSize: 10, color: Black()},
+	}
+	if len(tokens) != len(expected) {
+		t.Fatalf("%d tokens, %d expected: %+v", len(tokens), len(expected), tokens)
+	}
+	for i := range tokens {
+		if tokens[i] != expected[i] {
+			t.Errorf("token %d: %+v, %+v expected", i, tokens[i], expected[i])
+		}
+	}
+
+	pdf := &gopdf.GoPdf{}
+	pdf.Start(gopdf.Config{PageSize: *gopdf.PageSizeA4})
+	pdf.AddPage()
+	area := NewCellTextArea(gopdf.Left, gopdf.Top, "Questo è **un testo in grassetto che va a capo** e c{#FF0000;questo "+
+		"è rosso} i{0xF0A43;#0000FF} f{page}", false, "Roboto", 14, Black(), NewMargin(2),
+		NewRectangle(0, Solid, 1, White(), Black(), true))
+	area.SetMarkup(true)
+	area.Build(pdf, 120)
+	bold := 0
+	for _, line := range area.cellsTextMerged {
+		for _, token := range line.tokens {
+			if token.fontFamily == "Roboto-Bold" {
+				bold++
+			}
+		}
+	}
+	if len(area.cellsTextMerged) < 3 || bold != 8 {
+		t.Errorf("markup lost by word wrapping: %d lines, %d bold tokens", len(area.cellsTextMerged), bold)
+	}
+	area.Adjust(pdf, 10, 10, 120, area.MinHeight())
+	area.Render(pdf)
+	err := pdf.WritePdf(testOutputDirectory + "TestMarkup.pdf")
+	if err != nil {
+		log.Print(err.Error())
+		return
+	}
+}
+func TestPlainText(t *testing.T) {
+	values := []string{"________", `C:\temp\new`, "ID__123__X", "costs{1;2}", "a*b**c**", `c{#FF0000;x}\{`}
+	for _, value := range values {
+		cell := NewCellText(gopdf.Left, gopdf.Top, value, false, "Roboto", 10, Black(), NewMargin(2),
+			NewRectangle(0, Solid, 1, White(), Black(), true))
+		if len(cell.tokens) != 1 || cell.tokens[0].value != value || cell.tokens[0].fontFamily != "Roboto-Regular" ||
+			cell.tokens[0].fontSize != 10 || cell.tokens[0].color != Black() {
+			t.Errorf("%q: %+v", value, cell.tokens)
+		}
+		area := NewCellTextArea(gopdf.Left, gopdf.Top, value+" "+value, false, "Roboto", 10, Black(), NewMargin(2),
+			NewRectangle(0, Solid, 1, White(), Black(), true))
+		text := ""
+		for _, word := range area.cellsText {
+			for _, token := range word.tokens {
+				if token.fontFamily != "Roboto-Regular" || token.fontSize != 10 {
+					t.Errorf("%q: %+v", value, token)
+				}
+				text += token.value
+			}
+		}
+		if text != value+value {
+			t.Errorf("%q: %q", value, text)
+		}
+	}
+	//The icons and the fields are parsed also without markup
+	cell := NewCellText(gopdf.Left, gopdf.Top, "**a** i{0xF0A43;#0000FF} f{page}", false, "Roboto", 10, Black(),
+		NewMargin(2), NewRectangle(0, Solid, 1, White(), Black(), true))
+	if len(cell.tokens) != 4 || cell.tokens[0].value != "**a** " || cell.tokens[1].fontFamily != IconFontFamily ||
+		cell.tokens[3].field != FieldPage {
+		t.Errorf("tokens: %+v", cell.tokens)
+	}
+	cell.SetMarkup(true)
+	if cell.tokens[0].value != "a" || cell.tokens[0].fontFamily != "Roboto-Bold" {
+		t.Errorf("markup not parsed: %+v", cell.tokens)
+	}
+}
+func TestJustify(t *testing.T) {
+	pdf := &gopdf.GoPdf{}
+	pdf.Start(gopdf.Config{PageSize: *gopdf.PageSizeA4})
+	pdf.AddPage()
+	area := NewCellTextArea(Justify, gopdf.Top, "Il presente contratto i{0xF0A43;#0000FF} è regolato dalla legge "+
+		"italiana e ogni **controversia** sarà di competenza esclusiva del foro di Torino.", false,
+		"ArchitectsDaughter-Regular", 12, Black(), NewMargin(2), NewRectangle(0, Solid, 1, White(), Black(), true))
+	area.SetMarkup(true)
+	area.Build(pdf, 200)
+	area.Adjust(pdf, 10, 10, 200, area.MinHeight())
+	lines := area.cellsTextMerged
+	if len(lines) < 3 {
+		t.Fatalf("%d lines", len(lines))
+	}
+	for i, line := range lines {
+		x, _ := line.getTextStartPosition(pdf)
+		end := x + line.textWidth(pdf)
+		if i < len(lines)-1 && (x != 12 || math.Abs(end-208) > 0.01) {
+			t.Errorf("line %d not justified: %.2f-%.2f", i, x, end)
+		}
+		if i == len(lines)-1 && (x != 12 || line.gapExtra != 0) {
+			t.Errorf("last line justified")
+		}
+	}
+	area.Render(pdf)
+	err := pdf.WritePdf(testOutputDirectory + "TestJustify.pdf")
+	if err != nil {
+		log.Print(err.Error())
+		return
+	}
+}
+func TestWordBreak(t *testing.T) {
+	cases := []struct {
+		language  string
+		word      string
+		positions []int
+	}{
+		{HyphenationItalian, "contratto", []int{3, 7}},
+		{HyphenationItalian, "Controversia", []int{3, 6, 9}},
+		{HyphenationEnglish, "hyphenation", []int{2, 6, 7}},
+		{HyphenationEnglish, "jurisdiction", []int{2, 5, 8}},
+		{HyphenationEnglish, "agreement", []int{5}},
+	}
+	for _, c := range cases {
+		h, err := getHyphenator(c.language)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if positions := h.hyphenate(c.word); fmt.Sprint(positions) != fmt.Sprint(c.positions) {
+			t.Errorf("%s: %v, %v expected", c.word, positions, c.positions)
+		}
+	}
+	//The positions are units: the decomposed é (e\u0301) is one unit, like the composed one
+	h, _ := getHyphenator(HyphenationItalian)
+	for _, word := range []string{"pescatore", "p\u00e9scatore", "pe\u0301scatore"} {
+		tokens := []Token{{fontFamily: "ArchitectsDaughter-Regular", fontSize: 12, value: word}}
+		units := wordUnits(tokens)
+		hyphenated, from := "", 0
+		for _, p := range hyphenationPoints(h, tokens) {
+			hyphenated += word[from:units[p].start] + "-"
+			from = units[p].start
+		}
+		if hyphenated += word[from:]; hyphenated != word[:units[2].start]+"-sca-to-re" {
+			t.Errorf("%q hyphenated as %q", word, hyphenated)
+		}
+	}
+
+	pdf := &gopdf.GoPdf{}
+	pdf.Start(gopdf.Config{PageSize: *gopdf.PageSizeA4})
+	pdf.AddPage()
+	iban := "IT60X0542811101000000123456"
+	area := NewCellTextArea(gopdf.Left, gopdf.Top, "IBAN: "+iban+" https://www.example.com/a/very/long/path", false,
+		"ArchitectsDaughter-Regular", 14, Black(), NewMargin(2), NewRectangle(0, Solid, 1, White(), Black(), true))
+	area.SetWrap(WrapBreakWord)
+	area.Build(pdf, 100)
+	text := ""
+	for _, line := range area.cellsTextMerged {
+		for _, token := range line.tokens {
+			if !token.gap {
+				text += token.value
+			}
+		}
+		if line.MinWidth(pdf) > 96 {
+			t.Errorf("line too wide: %.2f", line.MinWidth(pdf))
+		}
+	}
+	if text != "IBAN:"+iban+"https://www.example.com/a/very/long/path" {
+		t.Errorf("text lost: %s", text)
+	}
+	area.Adjust(pdf, 10, 10, 100, area.MinHeight())
+	area.Render(pdf)
+
+	area = NewCellTextArea(Justify, gopdf.Top, "Le parti convengono che ogni controversia relativa all'interpretazione "+
+		"del presente contratto sarà di competenza esclusiva del foro di Torino.", false,
+		"ArchitectsDaughter-Regular", 14, Black(), NewMargin(2), NewRectangle(0, Solid, 1, White(), Black(), true))
+	if err := area.SetHyphenation(HyphenationItalian); err != nil {
+		t.Fatal(err)
+	}
+	if err := area.SetHyphenation("xx"); err == nil {
+		t.Errorf("unknown language accepted")
+	}
+	area.SetHyphenation(HyphenationItalian)
+	area.Build(pdf, 150)
+	hyphens := 0
+	for _, line := range area.cellsTextMerged {
+		if line.tokens[len(line.tokens)-1].value == "-" {
+			hyphens++
+		}
+	}
+	if hyphens == 0 {
+		t.Errorf("no word hyphenated")
+	}
+	area.Adjust(pdf, 120, 10, 150, area.MinHeight())
+	area.Render(pdf)
+	err := pdf.WritePdf(testOutputDirectory + "TestWordBreak.pdf")
+	if err != nil {
+		log.Print(err.Error())
+		return
+	}
+}
+func TestParagraphs(t *testing.T) {
+	pdf := &gopdf.GoPdf{}
+	pdf.Start(gopdf.Config{PageSize: *gopdf.PageSizeA4})
+	pdf.AddPage()
+	newArea := func(value string) *CellTextArea {
+		return NewCellTextArea(gopdf.Left, gopdf.Top, value, false, "ArchitectsDaughter-Regular", 12, Black(),
+			NewMargin(0), NewRectangle(0, Solid, 1, White(), Black(), true))
+	}
+	line := newArea("").lineSpacing()
+	//Without the font metrics the line spacing is the height of the text computed by gopdf
+	if line != gopdf.ContentObjCalTextHeight(12) {
+		t.Errorf("line spacing %.2f, %.2f expected", line, gopdf.ContentObjCalTextHeight(12))
+	}
+	value := "Primo paragrafo\nsecondo paragrafo\n\nterzo paragrafo dopo una riga vuota"
+
+	area := newArea(value)
+	area.Build(pdf, 500)
+	if len(area.cellsTextMerged) != 1 || math.Abs(area.MinHeight()-line*DefaultLineHeight) > 1e-9 {
+		t.Errorf("newlines not collapsed: %d lines, height %.2f", len(area.cellsTextMerged), area.MinHeight())
+	}
+	area.SetLineHeight(2)
+	area.Build(pdf, 500)
+	if math.Abs(area.MinHeight()-line*2) > 1e-9 {
+		t.Errorf("line height: %.2f, %.2f expected", area.MinHeight(), line*2)
+	}
+
+	area = newArea(value)
+	area.SetKeepNewlines(true)
+	area.SetParagraphSpacing(10)
+	area.Build(pdf, 500)
+	if len(area.cellsTextMerged) != 3 {
+		t.Fatalf("%d lines, 3 expected", len(area.cellsTextMerged))
+	}
+	expected := 4*line*DefaultLineHeight + 2*10
+	if math.Abs(area.MinHeight()-expected) > 1e-9 {
+		t.Errorf("height: %.2f, %.2f expected", area.MinHeight(), expected)
+	}
+	area.Adjust(pdf, 10, 10, 500, area.MinHeight())
+	area.Render(pdf)
+
+	//The space of the paragraph is not kept at the top of the next part
+	next := area.Split(pdf, 2*line*DefaultLineHeight+10, 0)
+	if next == nil {
+		t.Fatal("not split")
+	}
+	if math.Abs(next.MinHeight()-line*DefaultLineHeight) > 1e-9 {
+		t.Errorf("next part height: %.2f, %.2f expected", next.MinHeight(), line*DefaultLineHeight)
+	}
+
+	area = NewCellTextArea(Justify, gopdf.Top, "Un paragrafo giustificato su più righe, con l'ultima riga "+
+		"allineata a sinistra.\nUn altro paragrafo giustificato, anche questo su più righe.", false,
+		"ArchitectsDaughter-Regular", 12, Black(), NewMargin(2), NewRectangle(0, Solid, 1, White(), Black(), true))
+	area.SetKeepNewlines(true)
+	area.SetParagraphSpacing(6)
+	area.SetLineHeight(1.2)
+	area.Build(pdf, 200)
+	area.Adjust(pdf, 10, 200, 200, area.MinHeight())
+	for i, line := range area.cellsTextMerged {
+		paragraphEnd := i == len(area.cellsTextMerged)-1 || area.cellsTextMerged[i+1].newlines > 0
+		if (line.gapExtra > 0) == paragraphEnd {
+			t.Errorf("line %d: gap %.2f, last line of the paragraph %t", i, line.gapExtra, paragraphEnd)
+		}
+	}
+	area.Render(pdf)
+	err := pdf.WritePdf(testOutputDirectory + "TestParagraphs.pdf")
+	if err != nil {
+		log.Print(err.Error())
+		return
+	}
+}
+func TestOverflow(t *testing.T) {
+	pdf := &gopdf.GoPdf{}
+	pdf.Start(gopdf.Config{PageSize: *gopdf.PageSizeA4})
+	pdf.AddPage()
+	font := "ArchitectsDaughter-Regular"
+	newCell := func(value string, overflow int) *CellText {
+		cell := NewCellText(gopdf.Left, gopdf.Middle, value, false, font, 12, Black(), NewMargin(2),
+			NewRectangle(0, Solid, 1, White(), Black(), true))
+		cell.SetOverflow(overflow)
+		return cell
+	}
+	text := func(cell *CellText) string {
+		value := ""
+		for _, token := range cell.tokens {
+			value += token.value
+		}
+		return value
+	}
+	code := "INV-2024-000123"
+	width := Width(pdf, font, 12, ShortenCharacters+"000123") + 4.5
+	y := 10.0
+	render := func(cell *CellText, width float64) {
+		cell.Adjust(pdf, 10, y, width, cell.MinHeight())
+		cell.Render(pdf)
+		y += cell.MinHeight() + 5
+	}
+
+	cell := newCell(code, OverflowEllipsisStart)
+	cell.Build(pdf, width)
+	if value := text(cell); value != ShortenCharacters+"000123" {
+		t.Errorf("ellipsis start: %s", value)
+	}
+	render(cell, width)
+	cell = newCell(code, OverflowEllipsisMiddle)
+	cell.Build(pdf, width+10)
+	if value := text(cell); !strings.HasPrefix(value, "INV") || !strings.HasSuffix(value, "123") ||
+		!strings.Contains(value, ShortenCharacters) {
+		t.Errorf("ellipsis middle: %s", value)
+	}
+	render(cell, width+10)
+	cell = newCell(code, OverflowEllipsisEnd)
+	cell.Build(pdf, width)
+	if value := text(cell); !strings.HasPrefix(value, "INV") || !strings.HasSuffix(value, ShortenCharacters) {
+		t.Errorf("ellipsis end: %s", value)
+	}
+	render(cell, width)
+
+	//The icon is cut like the text, with the ellipsis
+	cell = newCell("Totale i{0xF0A43;#0000FF} 1.234,56 EUR", OverflowEllipsisEnd)
+	cell.Build(pdf, Width(pdf, font, 12, "Totale 1.234")+4)
+	if value := text(cell); !strings.HasSuffix(value, ShortenCharacters) || cell.MinWidth(pdf) > Width(pdf, font, 12, "Totale 1.234")+4 {
+		t.Errorf("icon: %s", value)
+	}
+	render(cell, Width(pdf, font, 12, "Totale 1.234")+4)
+
+	cell = newCell(code, OverflowClip)
+	cell.Build(pdf, width)
+	if value := text(cell); !strings.HasPrefix(code, value) || len(value) == 0 || cell.MinWidth(pdf) > width {
+		t.Errorf("clip: %s", value)
+	}
+	render(cell, width)
+
+	full := Width(pdf, font, 12, code) + 4
+	cell = newCell(code, OverflowShrink)
+	cell.Build(pdf, full*0.8)
+	if cell.tokens[0].fontSize >= 12 || cell.tokens[0].fontSize < DefaultMinFontSize || text(cell) != code {
+		t.Errorf("shrink: %s size %d", text(cell), cell.tokens[0].fontSize)
+	}
+	render(cell, full*0.8)
+	cell.SetMinFontSize(11)
+	cell.Build(pdf, full*0.5)
+	if value := text(cell); !strings.HasSuffix(value, ShortenCharacters) || cell.tokens[0].fontSize != 11 {
+		t.Errorf("shrink to the minimum: %s size %d", value, cell.tokens[0].fontSize)
+	}
+
+	cell = newCell(code, OverflowError)
+	if err := SafeBuild(cell, pdf, width); !errors.Is(err, ErrTextOverflow) {
+		t.Errorf("error expected: %v", err)
+	}
+	if err := SafeBuild(cell, pdf, full); err != nil {
+		t.Errorf("error not expected: %v", err)
+	}
+
+	cell = newCell("Codice "+code+" del documento", OverflowWrap)
+	cell.Build(pdf, width)
+	if cell.wrapped == nil || len(cell.wrapped.cellsTextMerged) < 3 || cell.MinWidth(pdf) > width {
+		t.Fatalf("not wrapped")
+	}
+	value := ""
+	for _, line := range cell.wrapped.cellsTextMerged {
+		for _, token := range line.tokens {
+			value += token.value
+		}
+	}
+	if strings.ReplaceAll(value, " ", "") != "Codice"+code+"deldocumento" {
+		t.Errorf("wrap: %s", value)
+	}
+	render(cell, width)
+	err := pdf.WritePdf(testOutputDirectory + "TestOverflow.pdf")
+	if err != nil {
+		log.Print(err.Error())
+		return
+	}
+}
+func TestDiagnostics(t *testing.T) {
+	font := "ArchitectsDaughter-Regular"
+	newCell := func(value string) *CellText {
+		return NewCellText(gopdf.Left, gopdf.Top, value, false, font, 10, Black(), NewMargin(2),
+			NewRectangle(0, Solid, 1, White(), Black(), true))
+	}
+	header := NewGrid([][]Component{{newCell("Intestazione molto lunga del documento numero 12345"), newCell("")}},
+		NewRectangle(0, Solid, 0, White(), Black(), true), NewMargin(0), gopdf.Left, gopdf.Top)
+	header.SetColumns(NewFixedColumn(80), NewWeightColumn(1))
+	grid := NewGrid([][]Component{{newCell("Un testo molto lungo i{0xF0A43;#0000FF}"),
+		NewCellImage(gopdf.Center, gopdf.Middle, NewMargin(1), NewRectangle(0, Solid, 1, White(), Black(), true),
+			"not an image", 72), newCell("Breve")}},
+		NewRectangle(0, Solid, 0, White(), Black(), true), NewMargin(0), gopdf.Left, gopdf.Top)
+	grid.SetColumns(NewFixedColumn(80), NewFixedColumn(80), NewWeightColumn(1))
+	report := NewReport(*gopdf.PageSizeA4, 10, 10, 10, 10,
+		20)
+	report.SetHeaderCP(header)
+	report.AddContentCP(getTable(60, 5))
+	report.AddContentCP(grid)
+	report.Build()
+	last := len(report.pages)
+	headers, kinds := 0, make(map[int]int)
+	for _, d := range report.Diagnostics() {
+		switch {
+		case d.Path == "header/Grid[0][0]/CellText":
+			headers++
+			if d.Kind != DiagnosticTruncated || !strings.HasSuffix(d.Shown, ShortenCharacters) ||
+				d.Value != "Intestazione molto lunga del documento numero 12345" {
+				t.Errorf("header: %s", d)
+			}
+		case d.Page != last:
+			t.Errorf("page: %s, %d expected", d, last)
+		case d.Kind == DiagnosticTruncated && d.Path == "contents[1]/Grid[0][0]/CellText":
+			kinds[d.Kind]++
+			if d.Value != "Un testo molto lungo i{0xF0A43;#0000FF}" {
+				t.Errorf("value: %s", d)
+			}
+		case d.Kind == DiagnosticTokenDropped && d.Path == "contents[1]/Grid[0][0]/CellText":
+			kinds[d.Kind]++
+		case d.Kind == DiagnosticImageFallback && d.Path == "contents[1]/Grid[0][1]/CellImage":
+			kinds[d.Kind]++
+			if d.Value != "not an image" || d.Reason == "" {
+				t.Errorf("image: %s", d)
+			}
+		default:
+			t.Errorf("not expected: %s", d)
+		}
+	}
+	if headers != last || kinds[DiagnosticTruncated] != 1 || kinds[DiagnosticTokenDropped] != 1 ||
+		kinds[DiagnosticImageFallback] != 1 {
+		t.Errorf("diagnostics: %v", report.Diagnostics())
+	}
+	var buf bytes.Buffer
+	if err := report.Stream(&buf); err != nil || len(report.Diagnostics()) != headers+3 {
+		t.Errorf("stream diagnostics: %v %v", err, report.Diagnostics())
+	}
+
+	//The header measured again with the real number of pages is truncated
+	pdf := &gopdf.GoPdf{}
+	pdf.Start(gopdf.Config{PageSize: *gopdf.PageSizeA4})
+	pages := newCell("Pagine f{pages}")
+	header = NewGrid([][]Component{{pages, newCell("")}},
+		NewRectangle(0, Solid, 0, White(), Black(), true), NewMargin(0), gopdf.Left, gopdf.Top)
+	header.SetColumns(NewFixedColumn(Width(pdf, font, 10, "Pagine 1")+5), NewWeightColumn(1))
+	report = NewReport(*gopdf.PageSizeA4, 10, 10, 10, 10,
+		20)
+	report.SetPageFieldReserve(1)
+	report.SetHeaderCP(header)
+	report.AddContentCP(getTable(400, 5))
+	report.Build()
+	if len(report.pages) < 10 || len(report.Diagnostics()) != 2*len(report.pages) { //Truncated, f{pages} dropped
+		t.Errorf("header diagnostics in %d pages: %v", len(report.pages), report.Diagnostics())
+	}
+
+	//A text shrunk, then a content taller than an empty page
+	shrunk := newCell("Testo ridotto per entrare")
+	shrunk.SetOverflow(OverflowShrink)
+	shrunk.SetMinFontSize(4)
+	grid = NewGrid([][]Component{{shrunk, newCell("")}},
+		NewRectangle(0, Solid, 0, White(), Black(), true), NewMargin(0), gopdf.Left, gopdf.Top)
+	grid.SetColumns(NewFixedColumn(Width(pdf, font, 10, "Testo ridotto per entrare")*0.7+4), NewWeightColumn(1))
+	report = NewReport(*gopdf.PageSizeA4, 10, 10, 10, 10,
+		20)
+	report.AddContentCP(grid)
+	report.Build()
+	diagnostics := report.Diagnostics()
+	if len(diagnostics) != 1 || diagnostics[0].Kind != DiagnosticShrunk || diagnostics[0].Page != 1 ||
+		diagnostics[0].Path != "contents[0]/Grid[0][0]/CellText" || diagnostics[0].Shown != "Testo ridotto per entrare" ||
+		diagnostics[0].Reason != "font size 10 to 7" {
+		t.Errorf("shrink diagnostics: %v", diagnostics)
+	}
+	report.AddContentCP(NewGrid([][]Component{{getCellTextAreaStr(strings.Repeat("Text ", 3000))}},
+		NewRectangle(0, Solid, 0, White(), Black(), true), NewMargin(0), gopdf.Left, gopdf.Top))
+	if err := report.SafeBuild(); !errors.Is(err, ErrNoProgress) {
+		t.Errorf("wrong no progress error: %v", err)
+	}
+	diagnostics = report.Diagnostics()
+	if len(diagnostics) != 2 || diagnostics[0].Kind != DiagnosticShrunk ||
+		diagnostics[1].Kind != DiagnosticComponentDropped || diagnostics[1].Page != 2 ||
+		diagnostics[1].Path != "contents[1]/Grid" {
+		t.Errorf("dropped component diagnostics: %v", diagnostics)
+	}
+}
+func TestRotation(t *testing.T) {
+	pdf := &gopdf.GoPdf{}
+	pdf.Start(gopdf.Config{PageSize: *gopdf.PageSizeA4})
+	pdf.AddPage()
+	font := "ArchitectsDaughter-Regular"
+	newCell := func(value string, degrees float64) *CellText {
+		cell := NewCellText(gopdf.Center, gopdf.Bottom, value, false, font, 10, Black(), NewMargin(2),
+			NewRectangle(gopdf.AllBorders, Solid, 0.5, White(), Black(), true))
+		cell.SetRotation(degrees)
+		return cell
+	}
+	textWidth, textHeight := Width(pdf, font, 10, "Quantità ordinata"), Token{fontFamily: font, fontSize: 10}.Height()
+
+	cell := newCell("Quantità ordinata", 90)
+	cell.Build(pdf, 100)
+	if math.Abs(cell.MinWidth(pdf)-textHeight-4) > 1e-6 || math.Abs(cell.MinHeight()-textWidth-4) > 1e-6 {
+		t.Errorf("90°: %.2fx%.2f, %.2fx%.2f expected", cell.MinWidth(pdf), cell.MinHeight(), textHeight+4, textWidth+4)
+	}
+	cell = newCell("Quantità ordinata", 45)
+	cell.Build(pdf, 100)
+	side := (textWidth+textHeight)*math.Sqrt2/2 + 4
+	if math.Abs(cell.MinWidth(pdf)-side) > 1e-6 || math.Abs(cell.MinHeight()-side) > 1e-6 {
+		t.Errorf("45°: %.2fx%.2f, %.2fx%.2f expected", cell.MinWidth(pdf), cell.MinHeight(), side, side)
+	}
+	//Shortened to the length that fits the width
+	cell = newCell("Quantità ordinata", 30)
+	cell.Build(pdf, 50)
+	if cell.MinWidth(pdf) > 50 || !strings.HasSuffix(cell.tokens[len(cell.tokens)-1].value, ShortenCharacters) {
+		t.Errorf("30°: %.2f wide", cell.MinWidth(pdf))
+	}
+
+	header := []Component{newCell("Articolo", 0), newCell("Quantità ordinata", 90), newCell("Prezzo unitario", 90),
+		newCell("Sconto applicato", 45)}
+	m := [][]Component{header}
+	for i := 0; i < 5; i++ {
+		m = append(m, []Component{newCell("Articolo "+strconv.Itoa(i), 0), newCell(strconv.Itoa(i*3), 0),
+			newCell("12,50", 0), newCell("10%", 0)})
+	}
+	grid := NewGrid(m, NewRectangle(0, Solid, 0, White(), Black(), true), NewMargin(0), gopdf.Left, gopdf.Top)
+	grid.SetColumns(NewWeightColumn(1), NewAutoColumn(), NewAutoColumn(), NewAutoColumn())
+	grid.Build(pdf, 300)
+	if math.Abs(grid.rowHeights[0]-header[1].MinHeight()) > 1e-6 {
+		t.Errorf("header row height %.2f, %.2f expected", grid.rowHeights[0], header[1].MinHeight())
+	}
+	if grid.widths[1] >= grid.widths[0] {
+		t.Errorf("rotated column too wide: %.2f", grid.widths[1])
+	}
+	grid.Adjust(pdf, 10, 10, 300, grid.MinHeight())
+	grid.Render(pdf)
+	err := pdf.WritePdf(testOutputDirectory + "TestRotation.pdf")
+	if err != nil {
+		log.Print(err.Error())
+		return
+	}
+}
+func TestBidi(t *testing.T) {
+	text := func(tokens []Token) string {
+		value := ""
+		for _, token := range tokens {
+			value += token.value
+		}
+		return value
+	}
+	style := textStyle{fontFamily: "Arial-Regular", fontSize: 12, color: Black()}
+	shaping := map[string]string{
+		"سلام": "\uFEB3\uFEFC\uFEE1",
+		"محمد": "\uFEE3\uFEA4\uFEE4\uFEAA",
+		"دار":  "\uFEA9\uFE8D\uFEAD",
+		"Roma": "Roma",
+	}
+	for value, expected := range shaping {
+		if shaped := text(shapeArabic(parseMarkup(value, style, true))); shaped != expected {
+			t.Errorf("shaping %s: %q, %q expected", value, shaped, expected)
+		}
+	}
+	//Joined across the tokens of different styles
+	if shaped := text(shapeArabic(parseMarkup("م**ح**مد", style, true))); shaped != "\uFEE3\uFEA4\uFEE4\uFEAA" {
+		t.Errorf("shaping across tokens: %q", shaped)
+	}
+
+	ordering := []struct {
+		value    string
+		rtl      bool
+		expected string
+	}{
+		{"Via Roma 12", false, "Via Roma 12"},
+		{"שלום עולם", true, "םלוע םולש"},
+		{"רחוב 12", true, "12 בוחר"},
+		{"(שלום)", true, "(םולש)"},
+		{"Nome: שלום עולם, fine", false, "Nome: םלוע םולש, fine"},
+		{"שלום Via Roma", true, "Via Roma םולש"},
+		{"عدد ١٢٣", true, "١٢٣ ددع"},
+		{"Via שלום (עולם)", false, "Via (םלוע) םולש"},
+		{"⟨שלום⟩ «עולם»", true, "«םלוע» ⟨םולש⟩"},
+	}
+	for _, o := range ordering {
+		tokens := parseMarkup(o.value, style, true)
+		if isRightToLeft(tokens) != o.rtl {
+			t.Errorf("%s: direction not %t", o.value, o.rtl)
+		}
+		if visual := text(visualTokens(tokens, o.rtl)); visual != o.expected {
+			t.Errorf("%s: %q, %q expected", o.value, visual, o.expected)
+		}
+	}
+	//The icons are kept whole
+	tokens := visualTokens(parseMarkup("**שלום** i{0xF0A43;#0000FF} עולם", style, true), true)
+	if len(tokens) != 4 || tokens[1].fontFamily != IconFontFamily || text(tokens[3:]) != "םולש" {
+		t.Errorf("tokens: %v", tokens)
+	}
+
+	pdf := &gopdf.GoPdf{}
+	pdf.Start(gopdf.Config{PageSize: *gopdf.PageSizeA4})
+	pdf.AddPage()
+	area := NewCellTextArea(gopdf.Left, gopdf.Top, "שלום עולם, זוהי כתובת ברחוב הרצל 12 בתל אביב\n"+
+		"Via Roma 12, Torino\nالسلام عليكم، هذا عنوان في شارع الملك فهد", false, "Arial-Regular", 12, Black(),
+		NewMargin(2), NewRectangle(0, Solid, 1, White(), Black(), true))
+	area.SetKeepNewlines(true)
+	area.Build(pdf, 200)
+	area.Adjust(pdf, 10, 10, 200, area.MinHeight())
+	for _, line := range area.cellsTextMerged {
+		latin := line.tokens[0].value == "Via"
+		if line.rtl == latin || (line.horizontalAlign == gopdf.Right) == latin {
+			t.Errorf("line %q: rtl %t, align %d", text(line.tokens), line.rtl, line.horizontalAlign)
+		}
+		if !latin && math.Abs(line.rectangle.lowerX+line.rectangle.width-208) > 1e-6 {
+			t.Errorf("line %q not aligned right", text(line.tokens))
+		}
+	}
+	area.Render(pdf)
+	err := pdf.WritePdf(testOutputDirectory + "TestBidi.pdf")
+	if err != nil {
+		log.Print(err.Error())
+		return
+	}
+}
+func TestLineBreak(t *testing.T) {
+	style := textStyle{fontFamily: "Arial-Regular", fontSize: 12, color: Black()}
+	cases := map[string][]string{
+		"中文排版。":                     {"中", "文", "排", "版。"},
+		"「日本語」です":                   {"「日", "本", "語」", "で", "す"},
+		"カップ":                       {"カッ", "プ"},
+		"well-known":                {"well-", "known"},
+		"https://www.example.com/a": {"https://", "www.example.com/", "a"},
+		"10-20":                     {"10-20"},
+		"-5,00€":                    {"-5,00€"},
+		"(100%)":                    {"(100%)"},
+		"ภาษาไทย":                   {"ภาษา", "ไทย"},
+		"ทำงาน":                     {"ทำ", "งาน"},
+		"Straße":                    {"Straße"},
+		"🇮🇹🇫🇷🇩":                     {"🇮🇹", "🇫🇷", "🇩"},
+		"👍🏽👍":                       {"👍🏽", "👍"},
+		"👨\u200D👩\u200D👧":           {"👨\u200D👩\u200D👧"},
+		"שלום-עולם":                 {"שלום-עולם"},
+		"한국어":                       {"한", "국", "어"},
+		"\u1112\u1161\u11AB":        {"\u1112\u1161\u11AB"},
+		"abc（def）":                  {"abc", "（def）"},
+		"a\uFFFCb":                  {"a", "\uFFFC", "b"},
+		"①②":                        {"①②"},
+	}
+	for value, expected := range cases {
+		segments := make([]string, 0)
+		for _, segment := range lineBreakSegments(parseMarkup(value, style, true)) {
+			text := ""
+			for _, token := range segment {
+				text += token.value
+			}
+			segments = append(segments, text)
+		}
+		if fmt.Sprint(segments) != fmt.Sprint(expected) {
+			t.Errorf("%s: %v, %v expected", value, segments, expected)
+		}
+	}
+	//The marks are not separated from their letter
+	if segments := lineBreakSegments(parseMarkup("กิ่ไก่", style, true)); len(segments) != 2 {
+		t.Errorf("segments: %v", segments)
+	}
+
+	pdf := &gopdf.GoPdf{}
+	pdf.Start(gopdf.Config{PageSize: *gopdf.PageSizeA4})
+	pdf.AddPage()
+	value := "Documento: https://www.example.com/documenti/contratto-di-fornitura.pdf"
+	area := NewCellTextArea(gopdf.Left, gopdf.Top, value, false, "Arial-Regular", 12, Black(), NewMargin(2),
+		NewRectangle(0, Solid, 1, White(), Black(), true))
+	area.Build(pdf, 120)
+	text := ""
+	for _, line := range area.cellsTextMerged {
+		for _, token := range line.tokens {
+			text += token.value
+		}
+		if line.MinWidth(pdf) > 116 {
+			t.Errorf("line too wide: %.2f", line.MinWidth(pdf))
+		}
+	}
+	if text != value || len(area.cellsTextMerged) < 3 {
+		t.Errorf("text %q on %d lines", text, len(area.cellsTextMerged))
+	}
+	area.Adjust(pdf, 10, 10, 120, area.MinHeight())
+	area.Render(pdf)
+	err := pdf.WritePdf(testOutputDirectory + "TestLineBreak.pdf")
+	if err != nil {
+		log.Print(err.Error())
+		return
+	}
+}
+func TestFontFallbacks(t *testing.T) {
+	if Fonts.HasGlyph("Cinzel-Regular", 'Ж') || !Fonts.HasGlyph("Exo2-Regular", 'Ж') || !Fonts.HasGlyph("NotRegistered", 'Ж') {
+		t.Errorf("glyphs not read")
+	}
+	pdf := &gopdf.GoPdf{}
+	pdf.Start(gopdf.Config{PageSize: *gopdf.PageSizeA4})
+	pdf.AddPage()
+	cell := NewCellText(gopdf.Left, gopdf.Top, "Prezzo € 10, Жук **и α**", false, "Cinzel", 14, Black(), NewMargin(2),
+		NewRectangle(0, Solid, 1, White(), Black(), true))
+	cell.SetMarkup(true)
+	if len(cell.tokens) != 2 {
+		t.Errorf("tokens without fallbacks: %v", cell.tokens)
+	}
+	cell.SetFontFallbacks("Exo2", "Arial-Regular")
+	expected := []Token{{value: "Prezzo € 10, ", fontFamily: "Cinzel-Regular"}, {value: "Жук ", fontFamily: "Exo2-Regular"},
+		{value: "и ", fontFamily: "Exo2-Bold"}, {value: "α", fontFamily: "Arial-Regular"}}
+	if len(cell.tokens) != len(expected) {
+		t.Fatalf("tokens: %v", cell.tokens)
+	}
+	width := 0.0
+	for i, token := range cell.tokens {
+		if token.value != expected[i].value || token.fontFamily != expected[i].fontFamily {
+			t.Errorf("token %d: %q %s, %q %s expected", i, token.value, token.fontFamily, expected[i].value,
+				expected[i].fontFamily)
+		}
+		width += Width(pdf, expected[i].fontFamily, 14, expected[i].value)
+	}
+	if math.Abs(cell.textWidth(pdf)-width) > 1e-6 {
+		t.Errorf("width %.2f, %.2f expected", cell.textWidth(pdf), width)
+	}
+	cell.Build(pdf, 300)
+	cell.Adjust(pdf, 10, 10, 300, cell.MinHeight())
+	cell.Render(pdf)
+
+	area := NewCellTextArea(gopdf.Left, gopdf.Top, "Добро пожаловать, il totale è 120 € (αβγ)", false, "Cinzel", 14,
+		Black(), NewMargin(2), NewRectangle(0, Solid, 1, White(), Black(), true))
+	area.SetFontFallbacks("Exo2", "Arial-Regular")
+	area.Build(pdf, 150)
+	for _, line := range area.cellsTextMerged {
+		for _, token := range line.tokens {
+			for _, r := range token.value {
+				if !Fonts.HasGlyph(token.fontFamily, r) {
+					t.Errorf("%q not in %s", r, token.fontFamily)
+				}
+			}
+		}
+	}
+	area.Adjust(pdf, 10, 40, 150, area.MinHeight())
+	area.Render(pdf)
+	err := pdf.WritePdf(testOutputDirectory + "TestFontFallbacks.pdf")
+	if err != nil {
+		log.Print(err.Error())
+		return
+	}
+}
+func TestKerning(t *testing.T) {
+	info := Fonts.info("Lato-Regular")
+	if info == nil || info.kern('A', 'V') >= 0 || info.kern('V', 'A') >= 0 || info.kern('x', 'x') != 0 {
+		t.Fatalf("kerning not read")
+	}
+	ligatures := map[string]string{"Lato-Regular": "of\uFB01ce \uFB02", "Roboto-Regular": "o\uFB03ce \uFB02",
+		"ArchitectsDaughter-Regular": "office fl"}
+	for font, expected := range ligatures {
+		if text := Fonts.info(font).applyLigatures("office fl"); text != expected {
+			t.Errorf("%s: %q, %q expected", font, text, expected)
+		}
+	}
+
+	pdf := &gopdf.GoPdf{}
+	pdf.Start(gopdf.Config{PageSize: *gopdf.PageSizeA4})
+	pdf.AddPage()
+	plain := Token{value: "AVATAR To", fontFamily: "Lato-Regular", fontSize: 20}
+	kerned := plain
+	kerned.kerning = true
+	kerning := 0.0
+	for _, pair := range [][2]rune{{'A', 'V'}, {'V', 'A'}, {'A', 'T'}, {'T', 'A'}, {'A', 'R'}, {'T', 'o'}} {
+		kerning += float64(info.kern(pair[0], pair[1])) * 20 / info.unitsPerEm
+	}
+	if math.Abs(kerned.Width(pdf)-plain.Width(pdf)-kerning) > 1e-6 || kerning >= 0 {
+		t.Errorf("width %.2f, %.2f expected", kerned.Width(pdf), plain.Width(pdf)+kerning)
+	}
+	if len(kerned.textRuns()) < 2 || len(plain.textRuns()) != 1 {
+		t.Errorf("runs: %v", kerned.textRuns())
+	}
+	ligature := Token{value: "office", fontFamily: "Lato-Regular", fontSize: 20, ligatures: true}
+	if math.Abs(ligature.Width(pdf)-Width(pdf, "Lato-Regular", 20, "of\uFB01ce")) > 1e-6 {
+		t.Errorf("ligature width %.2f", ligature.Width(pdf))
+	}
+	shortened := kerned
+	if !shortened.Shorten(pdf, 60) || shortened.Width(pdf) > 60 {
+		t.Errorf("shortened %q: %.2f", shortened.value, shortened.Width(pdf))
+	}
+
+	for i, align := range []uint{gopdf.Left, gopdf.Center, gopdf.Right} {
+		cell := NewCellText(align, gopdf.Middle, "AVATAR **Tower** office", false, "Lato", 20, Black(), NewMargin(2),
+			NewRectangle(0, Solid, 1, White(), Black(), true))
+		cell.SetMarkup(true)
+		width := cell.MinWidth(pdf)
+		cell.SetKerning(true)
+		cell.SetLigatures(true)
+		if cell.MinWidth(pdf) >= width {
+			t.Errorf("kerned width %.2f, %.2f without kerning", cell.MinWidth(pdf), width)
+		}
+		cell.Build(pdf, 250)
+		cell.Adjust(pdf, 10, 10+float64(i)*40, 250, cell.MinHeight())
+		x, _ := cell.getTextStartPosition(pdf)
+		end := x
+		for _, token := range cell.tokens {
+			for _, run := range token.textRuns() {
+				end += Width(pdf, token.fontFamily, token.fontSize, run.text) + run.kerning
+			}
+		}
+		if align == gopdf.Right && math.Abs(end-(10+250-2)) > 1e-6 {
+			t.Errorf("text ends at %.4f", end)
+		}
+		cell.Render(pdf)
+	}
+	area := NewCellTextArea(Justify, gopdf.Top, "Fiorella affittò l'ufficio di Via Tavolara: VAT, AWAY e Yacht "+
+		"firmarono il contratto.", false, "Montserrat", 14, Black(), NewMargin(2),
+		NewRectangle(0, Solid, 1, White(), Black(), true))
+	area.SetKerning(true)
+	area.SetLigatures(true)
+	area.Build(pdf, 200)
+	area.Adjust(pdf, 10, 140, 200, area.MinHeight())
+	area.Render(pdf)
+	err := pdf.WritePdf(testOutputDirectory + "TestKerning.pdf")
+	if err != nil {
+		log.Print(err.Error())
+		return
+	}
+}
+func TestBaseline(t *testing.T) {
+	info := Fonts.info("PatrickHand-Regular")
+	token := Token{fontFamily: "PatrickHand-Regular", fontSize: 20, fontMetrics: true}
+	ascent, descent, _ := token.metrics()
+	if info == nil || math.Abs(ascent-info.ascender*20/info.unitsPerEm) > 1e-9 || descent <= 0 ||
+		math.Abs(token.Height()-ascent-descent) > 1e-9 {
+		t.Fatalf("metrics: %.2f %.2f", ascent, descent)
+	}
+	//The font metrics are opt-in, the height of gopdf otherwise
+	token.fontMetrics = false
+	if token.Height() != gopdf.ContentObjCalTextHeight(20) {
+		t.Errorf("height %.2f without the font metrics", token.Height())
+	}
+
+	pdf := &gopdf.GoPdf{}
+	pdf.Start(gopdf.Config{PageSize: *gopdf.PageSizeA4})
+	pdf.AddPage()
+	for i, align := range []uint{gopdf.Top, gopdf.Middle, gopdf.Bottom} {
+		cell := NewCellText(gopdf.Left, align, "Totale s{24;120,00} t{AmaticSC;€} gj", false, "PatrickHand", 12,
+			Black(), NewMargin(2), NewRectangle(gopdf.AllBorders, Solid, 0.5, White(), Black(), true))
+		cell.SetMarkup(true)
+		cell.SetFontMetrics(true)
+		ascent, descent := 0.0, 0.0
+		for _, token := range cell.tokens {
+			a, d, _ := token.metrics()
+			ascent, descent = math.Max(ascent, a), math.Max(descent, d)
+		}
+		big, _, _ := cell.tokens[1].metrics()
+		if ascent != big || math.Abs(cell.MinHeight()-ascent-descent-4) > 1e-9 {
+			t.Errorf("height %.2f, %.2f expected", cell.MinHeight(), ascent+descent+4)
+		}
+		cell.Build(pdf, 200)
+		cell.Adjust(pdf, 10+float64(i)*200, 10, 190, 60)
+		_, y := cell.getTextStartPosition(pdf)
+		//The text, from the highest ascender to the lowest descender, is inside the cell
+		switch align {
+		case gopdf.Top:
+			if math.Abs(y-ascent-12) > 1e-9 {
+				t.Errorf("top baseline at %.2f", y)
+			}
+		case gopdf.Middle:
+			if math.Abs((y-ascent-10)-(70-y-descent)) > 1e-9 {
+				t.Errorf("middle baseline at %.2f", y)
+			}
+		case gopdf.Bottom:
+			if math.Abs(y+descent-68) > 1e-9 {
+				t.Errorf("bottom baseline at %.2f", y)
+			}
+		}
+		cell.Render(pdf)
+	}
+	area := NewCellTextArea(gopdf.Left, gopdf.Top, "Righe con discendenti: gioco, pigiama, yoghurt. "+
+		"Ogni riga è distante dalla successiva quanto l'interlinea del font.", false, "PatrickHand", 14, Black(),
+		NewMargin(0), NewRectangle(0, Solid, 1, White(), Black(), true))
+	area.SetFontMetrics(true)
+	area.SetLineHeight(1)
+	area.Build(pdf, 150)
+	ascent, descent, lineGap := Token{fontFamily: "PatrickHand-Regular", fontSize: 14, fontMetrics: true}.metrics()
+	if lines := float64(len(area.cellsTextMerged)); lines < 2 ||
+		math.Abs(area.MinHeight()-lines*(ascent+descent+lineGap)) > 1e-9 {
+		t.Errorf("height %.2f of %.0f lines", area.MinHeight(), lines)
+	}
+	area.Adjust(pdf, 10, 100, 150, area.MinHeight())
+	area.Render(pdf)
+	err := pdf.WritePdf(testOutputDirectory + "TestBaseline.pdf")
+	if err != nil {
+		log.Print(err.Error())
+		return
+	}
+}
 func TestReport_1(t *testing.T) {
 	report := NewReport(*gopdf.PageSizeA4, 10, 10, 10, 10,
 		20)
@@ -87,7 +1591,7 @@ func TestReport_1(t *testing.T) {
 	report.SetFooterCP(getGrid())
 	report.Build()
 	report.Render()
-	err := report.pdf.WritePdf(testOutputDirectory + "TestReport_1.pdf")
+	err := report.WriteFile(testOutputDirectory + "TestReport_1.pdf")
 	if err != nil {
 		log.Print(err.Error())
 		return
@@ -101,7 +1605,7 @@ func TestReport_2(t *testing.T) {
 	report.SetFooterCP(getGrid())
 	report.Build()
 	report.Render()
-	err := report.pdf.WritePdf(testOutputDirectory + "TestReport_2.pdf")
+	err := report.WriteFile(testOutputDirectory + "TestReport_2.pdf")
 	if err != nil {
 		log.Print(err.Error())
 		return
@@ -115,7 +1619,7 @@ func TestReport_3(t *testing.T) {
 	report.SetFooterLP(getGrid())
 	report.Build()
 	report.Render()
-	err := report.pdf.WritePdf(testOutputDirectory + "TestReport_3.pdf")
+	err := report.WriteFile(testOutputDirectory + "TestReport_3.pdf")
 	if err != nil {
 		log.Print(err.Error())
 		return
@@ -130,7 +1634,90 @@ func TestReport_4(t *testing.T) {
 	report.SetFooterCP(getGrid())
 	report.Build()
 	report.Render()
-	err := report.pdf.WritePdf(testOutputDirectory + "TestReport_4.pdf")
+	err := report.WriteFile(testOutputDirectory + "TestReport_4.pdf")
+	if err != nil {
+		log.Print(err.Error())
+		return
+	}
+}
+func TestTextSpacing(t *testing.T) {
+	pdf := &gopdf.GoPdf{}
+	pdf.Start(gopdf.Config{PageSize: *gopdf.PageSizeA4})
+	pdf.AddPage()
+	plain := Token{value: "Brand Guidelines", fontFamily: "BebasNeue-Regular", fontSize: 20}
+	tracked := plain
+	tracked.letterSpacing = 1
+	tracked.transform = TextTransformUppercase
+	upper := Width(pdf, "BebasNeue-Regular", 20, "BRAND GUIDELINES")
+	if math.Abs(tracked.Width(pdf)-upper-16) > 1e-6 {
+		t.Errorf("tracked width %.2f, %.2f expected", tracked.Width(pdf), upper+16)
+	}
+	spaced := tracked
+	spaced.wordSpacing = 5
+	if math.Abs(spaced.Width(pdf)-tracked.Width(pdf)-5) > 1e-6 {
+		t.Errorf("word spacing width %.2f, %.2f expected", spaced.Width(pdf), tracked.Width(pdf)+5)
+	}
+	smallCaps := Token{value: "Via Roma", fontFamily: "Lato-Regular", fontSize: 20, transform: TextTransformSmallCaps}
+	runs := smallCaps.textRuns()
+	expected := []textRun{{text: "V", fontSize: 20}, {text: "IA", fontSize: 14}, {text: " R", fontSize: 20},
+		{text: "OMA", fontSize: 14}}
+	if len(runs) != len(expected) {
+		t.Fatalf("small caps runs: %v", runs)
+	}
+	for i := range runs {
+		if runs[i] != expected[i] {
+			t.Errorf("small caps run %d: %v, %v expected", i, runs[i], expected[i])
+		}
+	}
+	shortened := spaced
+	if !shortened.Shorten(pdf, 80) || shortened.Width(pdf) > 80 {
+		t.Errorf("shortened %q: %.2f", shortened.value, shortened.Width(pdf))
+	}
+
+	for i, align := range []uint{gopdf.Left, gopdf.Center, gopdf.Right} {
+		cell := NewCellText(align, gopdf.Middle, "Brand guidelines", false, "BebasNeue", 20, Black(), NewMargin(2),
+			NewRectangle(0, Solid, 1, White(), Black(), true))
+		width := cell.MinWidth(pdf)
+		cell.SetTextTransform(TextTransformUppercase)
+		cell.SetLetterSpacing(1)
+		if math.Abs(cell.MinWidth(pdf)-width-16) > 1e-6 {
+			t.Errorf("tracked cell width %.2f, %.2f expected", cell.MinWidth(pdf), width+16)
+		}
+		cell.Build(pdf, 250)
+		cell.Adjust(pdf, 10, 10+float64(i)*40, 250, cell.MinHeight())
+		x, _ := cell.getTextStartPosition(pdf)
+		if align == gopdf.Right && math.Abs(x+cell.textWidth(pdf)-(10+250-2)) > 1e-6 {
+			t.Errorf("text ends at %.4f", x+cell.textWidth(pdf))
+		}
+		cell.Render(pdf)
+	}
+	text := "Le linee guida del marchio richiedono titoli in maiuscolo spaziato, il maiuscoletto per le " +
+		"didascalie e una spaziatura tra le parole che i paragrafi devono rispettare andando a capo."
+	for _, spacing := range [][2]float64{{0, 3}, {1, 3}, {0, 8}, {1, 0}} {
+		spaced := NewCellTextArea(gopdf.Left, gopdf.Top, text, false, "Lato", 14, Black(), NewMargin(2),
+			NewRectangle(0, Solid, 1, White(), Black(), true))
+		spaced.SetLetterSpacing(spacing[0])
+		spaced.SetWordSpacing(spacing[1])
+		spaced.Build(pdf, 200)
+		for i, line := range spaced.cellsTextMerged {
+			if line.MinWidth(pdf) > 200-4+1e-6 {
+				t.Errorf("spacing %v: line %d %.2f wide", spacing, i, line.MinWidth(pdf))
+			}
+		}
+		if diagnostics := spaced.diagnostics(); len(diagnostics) > 0 {
+			t.Errorf("spacing %v: %v", spacing, diagnostics)
+		}
+	}
+	area := NewCellTextArea(Justify, gopdf.Top, "Le linee guida del marchio richiedono titoli in maiuscolo "+
+		"spaziato e maiuscoletto per le didascalie.", false, "Lato", 14, Black(), NewMargin(2),
+		NewRectangle(0, Solid, 1, White(), Black(), true))
+	area.SetTextTransform(TextTransformSmallCaps)
+	area.SetLetterSpacing(0.5)
+	area.SetWordSpacing(2)
+	area.Build(pdf, 200)
+	area.Adjust(pdf, 10, 140, 200, area.MinHeight())
+	area.Render(pdf)
+	err := pdf.WritePdf(testOutputDirectory + "TestTextSpacing.pdf")
 	if err != nil {
 		log.Print(err.Error())
 		return
@@ -0,0 +1,68 @@
+package reportengine
+
+import (
+	"github.com/signintech/gopdf"
+)
+
+type Section struct {
+	pageSize                 gopdf.Rect
+	rectangle                Rectangle
+	verticalComponentsMargin float64
+
+	header   Component
+	footer   Component
+	contents []Component
+
+	splitType       int
+	pageNumbering   int
+	firstPageNumber int
+}
+
+func NewSection(pageSize gopdf.Rect, marginLeft, marginRight, marginTop, marginBottom float64,
+	verticalComponentsMargin float64) *Section {
+	section := new(Section)
+	section.pageSize = pageSize
+	section.rectangle = NewRectangle(0, Solid, 0, White(), White(), true)
+	section.rectangle.lowerX = marginLeft
+	section.rectangle.lowerY = marginTop
+	section.rectangle.width = pageSize.W - marginLeft - marginRight
+	section.rectangle.height = pageSize.H - marginTop - marginBottom
+	section.verticalComponentsMargin = verticalComponentsMargin
+	section.splitType = SplitRepeatFirstRow
+	section.pageNumbering = PageNumberingContinue
+	section.firstPageNumber = 1
+	return section
+}
+
+func (t *Section) SetHeader(header Component) {
+	t.header = header
+}
+func (t *Section) SetFooter(footer Component) {
+	t.footer = footer
+}
+func (t *Section) AddContent(content Component) {
+	t.contents = append(t.contents, content)
+}
+
+// SetSplitType sets how the splittable contents are split between pages (SplitNormal, SplitRepeatFirstRow)
+func (t *Section) SetSplitType(splitType int) {
+	t.splitType = splitType
+}
+
+// SetPageNumbering sets how the pages of the section are numbered:
+//   - PageNumberingContinue: the numbering continues from the previous section
+//   - PageNumberingRestart: the numbering restarts from firstPageNumber, f{pages} is the last number before the next restart
+//   - PageNumberingNone: the pages are not numbered (f{page} is 0) and not counted
+func (t *Section) SetPageNumbering(pageNumbering int, firstPageNumber int) {
+	t.pageNumbering = pageNumbering
+	t.firstPageNumber = firstPageNumber
+}
+
+func (t Section) isEmpty() bool {
+	return t.header == nil && t.footer == nil && len(t.contents) == 0
+}
+func (t *Section) setPageContext(context PageContext) {
+	for _, c := range append([]Component{t.header, t.footer}, t.contents...) {
+		setPageContext(c, context)
+	}
+}
@@ -0,0 +1,30 @@
+package reportengine
+
+// SpanCell is a Grid cell that covers rowSpan rows and colSpan columns.
+// Like in HTML tables, the rows covered by a row span don't list the covered cell again.
+type SpanCell struct {
+	Component
+	rowSpan int
+	colSpan int
+}
+
+func NewSpanCell(component Component, rowSpan, colSpan int) *SpanCell {
+	if rowSpan < 1 {
+		rowSpan = 1
+	}
+	if colSpan < 1 {
+		colSpan = 1
+	}
+	return &SpanCell{Component: component, rowSpan: rowSpan, colSpan: colSpan}
+}
+
+func (t *SpanCell) SetPageContext(context PageContext) {
+	setPageContext(t.Component, context)
+}
+
+func getSpan(component Component) (rowSpan, colSpan int) {
+	if sc, ok := component.(*SpanCell); ok {
+		return sc.rowSpan, sc.colSpan
+	}
+	return 1, 1
+}
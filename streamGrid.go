@@ -0,0 +1,136 @@
+package reportengine
+
+import (
+	"github.com/signintech/gopdf"
+)
+
+// RowSource returns the next row of a StreamGrid, ok is false when there are no more rows
+type RowSource func() (row []Component, ok bool)
+
+// NewChannelRowSource returns a RowSource that reads the rows from a channel, until it is closed
+func NewChannelRowSource(rows <-chan []Component) RowSource {
+	return func() ([]Component, bool) {
+		row, ok := <-rows
+		return row, ok
+	}
+}
+
+// prefetcher is implemented by the components that read their contents while the report is laid out
+type prefetcher interface {
+	Component
+	prefetch(pdf *gopdf.GoPdf, height float64)
+}
+
+// StreamGrid is a Grid that reads its rows from a RowSource while the report is laid out, holding in memory
+// only the rows of the page being laid out. The widths of the columns are computed from the header rows,
+// the rows of the source can span more columns (SpanCell) but not more rows.
+// With SplitRepeatFirstRow all the header rows are repeated on every page.
+type StreamGrid struct {
+	*Grid
+	header   [][]Component
+	source   RowSource
+	maxWidth float64 //width available for the matrix
+	done     bool
+}
+
+func NewStreamGrid(header [][]Component, source RowSource, rectangle Rectangle, minMargin Margin,
+	horizontalAlign, verticalAlign uint) *StreamGrid {
+	grid := new(StreamGrid)
+	grid.Grid = NewGrid(nil, rectangle, minMargin, horizontalAlign, verticalAlign)
+	grid.header = header
+	grid.source = source
+	return grid
+}
+
+func (t *StreamGrid) Build(pdf *gopdf.GoPdf, maxWidth float64) {
+	if err := t.checkBuild(pdf, maxWidth); err != nil {
+		panic(err)
+	}
+	t.Grid.matrix = append([][]Component{}, t.header...)
+	t.Grid.Build(pdf, maxWidth)
+	t.maxWidth = maxWidth - t.minMargin.left - t.minMargin.right
+}
+func (t *StreamGrid) checkBuild(*gopdf.GoPdf, float64) *ComponentError {
+	if t.source == nil { //Already laid out
+		return newComponentError(t, "Build", ErrSourceConsumed)
+	}
+	return nil
+}
+func (t *StreamGrid) Split(_ *gopdf.GoPdf, firstHeight float64, splitType int) Component {
+	firstGroup := 0
+	if splitType == SplitRepeatFirstRow {
+		firstGroup = len(t.header)
+	}
+	grid := t.splitAt(t.lastFittingRow(firstHeight), firstGroup)
+	if grid == nil {
+		return nil
+	}
+	next := &StreamGrid{Grid: grid, header: t.header, source: t.source, maxWidth: t.maxWidth, done: t.done}
+	t.source = nil
+	t.done = true
+	return next
+}
+
+// Not copied: the rows are read once, a StreamGrid can be laid out only once
+func (t *StreamGrid) clone() Component {
+	return t
+}
+
+// Read rows from the source until the grid is taller than height or the rows are finished
+func (t *StreamGrid) prefetch(pdf *gopdf.GoPdf, height float64) {
+	for !t.done && t.MinHeight() <= height {
+		row, ok := t.source()
+		if !ok {
+			t.done = true
+			t.source = nil
+			break
+		}
+		t.appendRow(pdf, row)
+	}
+}
+
+// Build the cells of the row and add it at the bottom of the grid
+func (t *StreamGrid) appendRow(pdf *gopdf.GoPdf, row []Component) {
+	cells := make([]cellLayout, len(row))
+	nColumns := 0
+	for j := range row {
+		_, colSpan := getSpan(row[j])
+		cells[j] = cellLayout{col: nColumns, rowSpan: 1, colSpan: colSpan}
+		nColumns += colSpan
+	}
+	height := 0.0
+	for j := range cells {
+		c := &cells[j]
+		if nColumns == len(t.widths) {
+			for k := 0; k < c.col+c.colSpan; k++ {
+				if k < c.col {
+					c.x += t.widths[k]
+				} else {
+					c.width += t.widths[k]
+				}
+			}
+		} else { //Row not aligned with the columns
+			unit := t.maxWidth / float64(nColumns)
+			c.x = float64(c.col) * unit
+			c.width = float64(c.colSpan) * unit
+		}
+		row[j].Build(pdf, c.width)
+		if temp := row[j].MinHeight(); temp > height {
+			height = temp
+		}
+	}
+	rowY := t.minMargin.top + t.matrixHeight()
+	for j := range cells {
+		cells[j].height = height
+		row[j].Adjust(pdf, t.minMargin.left+cells[j].x, rowY, cells[j].width, height)
+		if cells[j].x+cells[j].width > t.matrixWidth {
+			t.matrixWidth = cells[j].x + cells[j].width
+		}
+	}
+	t.matrix = append(t.matrix, row)
+	t.cells = append(t.cells, cells)
+	t.rowHeights = append(t.rowHeights, height)
+	t.rowsHeight += height
+	t.breakable = append(t.breakable, true)
+	t.rectangle.height = t.MinHeight()
+}
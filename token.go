@@ -3,14 +3,36 @@ package reportengine
 import (
 	"github.com/signintech/gopdf"
 	"log"
+	"math"
 	"strconv"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
 )
 
 type Token struct {
-	value      string
-	fontFamily string
-	fontSize   int
-	color      Color
+	value         string
+	fontFamily    string
+	fontSize      int
+	color         Color
+	field         string
+	fieldLayout   string
+	context       PageContext
+	gap           bool    //Space between two words of a CellTextArea line
+	kerning       bool    //Kerning of the font (GPOS or kern table)
+	ligatures     bool    //Standard ligatures of the font (GSUB)
+	fontMetrics   bool    //Positioned with the ascender, the descender and the line gap of the font
+	letterSpacing float64 //Added after every character
+	wordSpacing   float64 //Added after every space
+	transform     int     //TextTransformUppercase, ...
+}
+
+// Part of the text of a token written at once, followed by the kerning and the word spacing with the next part
+type textRun struct {
+	text     string
+	kerning  float64
+	fontSize int //Smaller than the size of the token for the synthesized small capitals
 }
 
 func (t Token) Render(pdf *gopdf.GoPdf, lowerX float64, upperY float64) {
@@ -30,21 +52,118 @@ func (t Token) Render(pdf *gopdf.GoPdf, lowerX float64, upperY float64) {
 			return
 		}
 	}
-	pdf.SetX(lowerX)
-	pdf.SetY(upperY)
-	err = pdf.Text(t.value)
-	if err != nil {
-		log.Println(err.Error())
-		return
+	for _, run := range t.textRuns() { //At the same positions measured by Width
+		advance := t.runWidth(pdf, run) //Sets the size of the run
+		pdf.SetCharSpacing(t.letterSpacing)
+		pdf.SetX(lowerX)
+		pdf.SetY(upperY)
+		err = pdf.Text(run.text)
+		pdf.SetCharSpacing(0)
+		if err != nil {
+			log.Println(err.Error())
+			return
+		}
+		lowerX += advance
 	}
 }
 
 func (t Token) Width(pdf *gopdf.GoPdf) float64 {
-	return Width(pdf, t.fontFamily, t.fontSize, t.value)
+	width := 0.0
+	for _, run := range t.textRuns() {
+		width += t.runWidth(pdf, run)
+	}
+	return width
+}
+
+// Return the advance of a run: its text with the letter spacing after every character, the kerning
+// and the word spacing. The font of the pdf is set to the one of the run.
+func (t Token) runWidth(pdf *gopdf.GoPdf, run textRun) float64 {
+	return Width(pdf, t.fontFamily, run.fontSize, run.text) + run.kerning +
+		t.letterSpacing*float64(utf8.RuneCountInString(run.text))
+}
+
+// Return the width of value written with the font and the features of the token
+func (t Token) valueWidth(pdf *gopdf.GoPdf, value string) float64 {
+	t.value = value
+	t.field = ""
+	return t.Width(pdf)
+}
+
+// Return the text of the token with the case transform and the ligatures, split where the kerning or
+// the word spacing moves the next character and where the size changes (small capitals)
+func (t Token) textRuns() []textRun {
+	text := t.text()
+	if t.fontFamily == IconFontFamily || !t.kerning && !t.ligatures && t.wordSpacing == 0 &&
+		t.transform == TextTransformNone {
+		return []textRun{{text: text, fontSize: t.fontSize}}
+	}
+	switch t.transform {
+	case TextTransformUppercase:
+		text = strings.ToUpper(text)
+	case TextTransformLowercase:
+		text = strings.ToLower(text)
+	}
+	info := Fonts.info(t.fontFamily)
+	if info != nil && t.ligatures && t.transform != TextTransformSmallCaps {
+		text = info.applyLigatures(text)
+	}
+	runes := []rune(text)
+	sizes := make([]int, len(runes))
+	for i, r := range runes {
+		sizes[i] = t.fontSize
+		if t.transform != TextTransformSmallCaps || !unicode.IsLower(r) {
+			continue
+		}
+		if info != nil {
+			if c, ok := info.smallCap(r); ok { //The small capital of the font
+				runes[i] = c
+				continue
+			}
+		}
+		runes[i] = unicode.ToUpper(r) //Synthesized, a smaller capital
+		sizes[i] = int(math.Max(1, math.Round(float64(t.fontSize)*SmallCapsScale)))
+	}
+	runs := make([]textRun, 0, 1)
+	start := 0
+	for i, r := range runes {
+		last := i == len(runes)-1
+		adjustment := 0.0
+		if r == ' ' {
+			adjustment += t.wordSpacing
+		}
+		if !last && t.kerning && info != nil && sizes[i] == sizes[i+1] {
+			adjustment += float64(info.kern(r, runes[i+1])) * float64(sizes[i]) / info.unitsPerEm
+		}
+		if adjustment != 0 || last || sizes[i] != sizes[i+1] {
+			runs = append(runs, textRun{text: string(runes[start : i+1]), kerning: adjustment, fontSize: sizes[i]})
+			start = i + 1
+		}
+	}
+	if len(runs) == 0 {
+		runs = append(runs, textRun{fontSize: t.fontSize})
+	}
+	return runs
 }
 
+// Height returns the height of the text: gopdf.ContentObjCalTextHeight, or from the ascender to the
+// descender of the font with the font metrics
 func (t Token) Height() float64 {
-	return gopdf.ContentObjCalTextHeight(t.fontSize)
+	ascent, descent, _ := t.metrics()
+	return ascent + descent
+}
+
+// Return the ascent, the descent (positive) and the line gap of the font of the token at its size.
+// Without the font metrics, or for a font that can't be read, only an ascent of gopdf.ContentObjCalTextHeight.
+func (t Token) metrics() (ascent, descent, lineGap float64) {
+	if !t.fontMetrics {
+		return gopdf.ContentObjCalTextHeight(t.fontSize), 0, 0
+	}
+	info := Fonts.info(t.fontFamily)
+	if info == nil || info.unitsPerEm == 0 || info.ascender+info.descender <= 0 {
+		return gopdf.ContentObjCalTextHeight(t.fontSize), 0, 0
+	}
+	scale := float64(t.fontSize) / info.unitsPerEm
+	return info.ascender * scale, info.descender * scale, info.lineGap * scale
 }
 
 /**
@@ -53,15 +172,15 @@ Return Value
 	-false: not possible shorten (remove token)
 */
 func (t *Token) Shorten(pdf *gopdf.GoPdf, maxWidth float64) bool {
-	width := Width(pdf, t.fontFamily, t.fontSize, t.value)
+	width := t.Width(pdf)
 	if width <= maxWidth {
 		return true
 	}
-	if t.fontFamily == IconFontFamily {
+	if t.fontFamily == IconFontFamily || t.field != "" {
 		return false
 	}
 	temp := ""
-	width = Width(pdf, t.fontFamily, t.fontSize, temp+ShortenCharacters)
+	width = t.valueWidth(pdf, temp+ShortenCharacters)
 	if width > maxWidth {
 		return false
 	}
@@ -70,10 +189,40 @@ func (t *Token) Shorten(pdf *gopdf.GoPdf, maxWidth float64) bool {
 		t.value = temp + ShortenCharacters
 		s, _ := strconv.Unquote(strconv.QuoteRune(r))
 		temp += s
-		width = Width(pdf, t.fontFamily, t.fontSize, temp+ShortenCharacters)
+		width = t.valueWidth(pdf, temp+ShortenCharacters)
 		if width > maxWidth {
 			return true
 		}
 	}
 	return false
 }
+
+// Return the value of the token, or the value of its field in the current page context
+func (t Token) text() string {
+	switch t.field {
+	case FieldPage:
+		return strconv.Itoa(t.context.Page)
+	case FieldPages:
+		if t.context.Pages == 0 { //Not known (Report.Stream)
+			return ""
+		}
+		return strconv.Itoa(t.context.Pages)
+	case FieldSectionPage:
+		return strconv.Itoa(t.context.SectionPage)
+	case FieldSectionPages:
+		if t.context.SectionPages == 0 {
+			return ""
+		}
+		return strconv.Itoa(t.context.SectionPages)
+	case FieldDate:
+		date := t.context.Date
+		if date.IsZero() {
+			date = time.Now()
+		}
+		if t.fieldLayout != "" {
+			return date.Format(t.fieldLayout)
+		}
+		return date.Format(FieldDateLayout)
+	}
+	return t.value
+}
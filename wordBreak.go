@@ -0,0 +1,139 @@
+package reportengine
+
+import (
+	"github.com/signintech/gopdf"
+	"golang.org/x/text/unicode/norm"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Part of a word that can't be broken: a character (with its marks) of a text token or a whole icon or field token
+type wordUnit struct {
+	token int
+	start int //Offset in the value of the token, 0 for icons and fields
+}
+
+// Break word so that the first part fits in width: at the last hyphenation point that fits (adding a hyphen),
+// otherwise, if force is true, after the last character that fits (at least one character).
+// Return false if the word can't be broken.
+func (t CellTextArea) breakWord(pdf *gopdf.GoPdf, word CellText, width float64, force bool) (first, rest CellText, ok bool) {
+	tokens := word.originalTokens
+	units := wordUnits(tokens)
+	if len(units) < 2 {
+		return first, rest, false
+	}
+	if t.hyphenator != nil {
+		positions := hyphenationPoints(t.hyphenator, tokens)
+		for i := len(positions) - 1; i >= 0; i-- {
+			firstTokens, restTokens := splitTokens(tokens, units[positions[i]])
+			hyphen := firstTokens[len(firstTokens)-1]
+			hyphen.value = "-"
+			first = word.withTokens(append(firstTokens, hyphen))
+			first.newlines = word.newlines
+			if first.MinWidth(pdf) <= width {
+				return first, word.withTokens(restTokens), true
+			}
+		}
+	}
+	if !force {
+		return first, rest, false
+	}
+	k := 1
+	for ; k < len(units)-1; k++ {
+		firstTokens, _ := splitTokens(tokens, units[k+1])
+		if word.withTokens(firstTokens).MinWidth(pdf) > width {
+			break
+		}
+	}
+	firstTokens, restTokens := splitTokens(tokens, units[k])
+	first = word.withTokens(firstTokens)
+	first.newlines = word.newlines
+	return first, word.withTokens(restTokens), true
+}
+
+func wordUnits(tokens []Token) []wordUnit {
+	units := make([]wordUnit, 0)
+	for i, token := range tokens {
+		if token.field != "" || token.fontFamily == IconFontFamily {
+			units = append(units, wordUnit{token: i})
+			continue
+		}
+		for start, r := range token.value {
+			if start > 0 && unicode.In(r, unicode.Mn, unicode.Mc, unicode.Me) { //A mark with its letter
+				continue
+			}
+			units = append(units, wordUnit{token: i, start: start})
+		}
+	}
+	return units
+}
+
+// Split the tokens before unit
+func splitTokens(tokens []Token, unit wordUnit) (first, rest []Token) {
+	first = append([]Token{}, tokens[:unit.token]...)
+	rest = make([]Token, 0, len(tokens)-unit.token)
+	if unit.start > 0 {
+		a, b := tokens[unit.token], tokens[unit.token]
+		a.value = a.value[:unit.start]
+		b.value = b.value[unit.start:]
+		first = append(first, a)
+		rest = append(rest, b)
+	} else {
+		rest = append(rest, tokens[unit.token])
+	}
+	return first, append(rest, tokens[unit.token+1:]...)
+}
+
+// Return the units (indexes of wordUnits) before which the word can be hyphenated. Only the words of text
+// tokens made of letters (and apostrophes), with punctuation at the start or the end, are hyphenated.
+func hyphenationPoints(h *hyphenator, tokens []Token) []int {
+	units := wordUnits(tokens)
+	//A letter for every unit, composed with its marks (e\u0301 is é), so the positions are units
+	letters := make([]rune, len(units))
+	for i, unit := range units {
+		token := tokens[unit.token]
+		if token.field != "" || token.fontFamily == IconFontFamily {
+			return nil
+		}
+		end := len(token.value)
+		if i+1 < len(units) && units[i+1].token == unit.token {
+			end = units[i+1].start
+		}
+		letters[i], _ = utf8.DecodeRuneInString(norm.NFC.String(token.value[unit.start:end]))
+	}
+	start, end := 0, len(letters)
+	for start < end && !unicode.IsLetter(letters[start]) {
+		start++
+	}
+	for end > start && !unicode.IsLetter(letters[end-1]) {
+		end--
+	}
+	for _, r := range letters[start:end] {
+		if !unicode.IsLetter(r) && r != '\'' && r != '’' {
+			return nil
+		}
+	}
+	positions := h.hyphenate(string(letters[start:end]))
+	for i := range positions {
+		positions[i] += start
+	}
+	return positions
+}
+
+// Return a copy of the word with other tokens, the copy doesn't start a paragraph
+func (t CellText) withTokens(tokens []Token) CellText {
+	t.originalTokens = tokens
+	t.newlines = 0
+	t.originalValue = ""
+	for _, token := range tokens {
+		t.originalValue += token.value
+	}
+	t.toOriginal()
+	return t
+}
+func insertCellText(cellsText []CellText, cellText CellText, index int) []CellText {
+	cellsText = append(cellsText, CellText{})
+	copy(cellsText[index+1:], cellsText[index:])
+	cellsText[index] = cellText
+	return cellsText
+}